@@ -0,0 +1,244 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+// Package catbustest is an in-process stand-in for a Catbus MQTT broker, for testing without a real one.
+//
+// Like an MQTT broker, it keeps the latest retained payload of each topic and sends them to each new subscription, and a retained empty payload removes the topic.
+// Messages are delivered synchronously, so once Publish returns every subscriber has handled it.
+package catbustest
+
+import (
+	"errors"
+	"strings"
+	"sync"
+
+	"go.eth.moe/catbus"
+)
+
+type (
+	// Broker routes messages between its Clients, and keeps the retained ones.
+	Broker struct {
+		mu       sync.Mutex
+		retained map[string]string
+		clients  map[*Client]bool
+	}
+
+	// Client is a catbus.Client of a Broker.
+	Client struct {
+		broker *Broker
+		opts   catbus.ClientOptions
+
+		mu            sync.Mutex
+		connected     bool
+		subscriptions []subscription
+		disconnected  chan struct{}
+	}
+
+	subscription struct {
+		pattern string
+		f       catbus.MessageHandler
+	}
+)
+
+// ErrNotConnected is returned by Clients that have not connected, or have disconnected.
+var ErrNotConnected = errors.New("not connected")
+
+var _ catbus.Client = &Client{}
+
+// NewBroker returns a Broker with the given retained payloads, by topic.
+func NewBroker(retained map[string]string) *Broker {
+	b := &Broker{
+		retained: map[string]string{},
+		clients:  map[*Client]bool{},
+	}
+	for topic, payload := range retained {
+		if payload != "" {
+			b.retained[topic] = payload
+		}
+	}
+	return b
+}
+
+// NewClient returns a Client of the Broker, which connects when Connect is called.
+func (b *Broker) NewClient(opts catbus.ClientOptions) *Client {
+	return &Client{
+		broker: b,
+		opts:   opts,
+	}
+}
+
+// Publish sends a message to every subscribed Client, as if from a device.
+func (b *Broker) Publish(topic string, retention catbus.Retention, payload string) {
+	b.mu.Lock()
+	if retention == catbus.Retain {
+		if payload == "" {
+			delete(b.retained, topic)
+		} else {
+			b.retained[topic] = payload
+		}
+	}
+	var clients []*Client
+	for c := range b.clients {
+		clients = append(clients, c)
+	}
+	b.mu.Unlock()
+
+	m := catbus.Message{
+		Topic:    topic,
+		Payload:  payload,
+		Retained: catbus.DontRetain,
+	}
+	for _, c := range clients {
+		c.deliver(m)
+	}
+}
+
+// Retained returns the retained payloads, by topic.
+func (b *Broker) Retained() map[string]string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	retained := make(map[string]string, len(b.retained))
+	for topic, payload := range b.retained {
+		retained[topic] = payload
+	}
+	return retained
+}
+
+// Drop drops every Client's connection with err, and reconnects them, as if the network had gone away and come back.
+// Like a real reconnect, their subscriptions are lost, and their ConnectHandlers are called again to subscribe.
+func (b *Broker) Drop(err error) {
+	b.mu.Lock()
+	var clients []*Client
+	for c := range b.clients {
+		clients = append(clients, c)
+	}
+	b.mu.Unlock()
+
+	for _, c := range clients {
+		c.mu.Lock()
+		c.subscriptions = nil
+		c.mu.Unlock()
+		if c.opts.DisconnectHandler != nil {
+			c.opts.DisconnectHandler(c, err)
+		}
+		if c.opts.ConnectHandler != nil {
+			c.opts.ConnectHandler(c)
+		}
+	}
+}
+
+// Connect connects to the Broker, calls the ConnectHandler, and blocks until Disconnect is called.
+func (c *Client) Connect() error {
+	c.mu.Lock()
+	if c.connected {
+		c.mu.Unlock()
+		return errors.New("already connected")
+	}
+	c.connected = true
+	disconnected := make(chan struct{})
+	c.disconnected = disconnected
+	c.mu.Unlock()
+
+	c.broker.mu.Lock()
+	c.broker.clients[c] = true
+	c.broker.mu.Unlock()
+
+	if c.opts.ConnectHandler != nil {
+		c.opts.ConnectHandler(c)
+	}
+	<-disconnected
+	return nil
+}
+
+// Disconnect disconnects from the Broker.
+// Like a deliberate disconnect from a real broker, the DisconnectHandler is not called.
+func (c *Client) Disconnect() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.connected {
+		return ErrNotConnected
+	}
+	c.connected = false
+	c.subscriptions = nil
+
+	c.broker.mu.Lock()
+	delete(c.broker.clients, c)
+	c.broker.mu.Unlock()
+
+	close(c.disconnected)
+	return nil
+}
+
+// Publish sends a message to every subscribed Client, including this one.
+func (c *Client) Publish(topic string, retention catbus.Retention, payload string) error {
+	if !c.isConnected() {
+		return ErrNotConnected
+	}
+	c.broker.Publish(topic, retention, payload)
+	return nil
+}
+
+// Subscribe calls f with each message whose topic matches, which may use the MQTT wildcards + and #.
+// The matching retained messages are sent first, before Subscribe returns.
+func (c *Client) Subscribe(topic string, f catbus.MessageHandler) error {
+	c.mu.Lock()
+	if !c.connected {
+		c.mu.Unlock()
+		return ErrNotConnected
+	}
+	c.subscriptions = append(c.subscriptions, subscription{topic, f})
+	c.mu.Unlock()
+
+	for retainedTopic, payload := range c.broker.Retained() {
+		if match(topic, retainedTopic) {
+			f(c, catbus.Message{
+				Topic:    retainedTopic,
+				Payload:  payload,
+				Retained: catbus.Retain,
+			})
+		}
+	}
+	return nil
+}
+
+func (c *Client) isConnected() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.connected
+}
+
+func (c *Client) deliver(m catbus.Message) {
+	c.mu.Lock()
+	var fs []catbus.MessageHandler
+	for _, s := range c.subscriptions {
+		if match(s.pattern, m.Topic) {
+			fs = append(fs, s.f)
+		}
+	}
+	c.mu.Unlock()
+
+	for _, f := range fs {
+		f(c, m)
+	}
+}
+
+// match returns whether topic matches pattern, where + matches one level, and a trailing # matches any number.
+func match(pattern, topic string) bool {
+	patterns := strings.Split(pattern, "/")
+	topics := strings.Split(topic, "/")
+	for i, p := range patterns {
+		if p == "#" {
+			return true
+		}
+		if i >= len(topics) {
+			return false
+		}
+		if p != "+" && p != topics[i] {
+			return false
+		}
+	}
+	return len(patterns) == len(topics)
+}
@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package catbustest
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+
+	"go.eth.moe/catbus"
+)
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		pattern, topic string
+		want           bool
+	}{
+		{"home/bedroom/lamp/power", "home/bedroom/lamp/power", true},
+		{"home/bedroom/lamp/power", "home/bedroom/lamp", false},
+		{"home/bedroom/lamp", "home/bedroom/lamp/power", false},
+		{"home/+/lamp/power", "home/bedroom/lamp/power", true},
+		{"home/+/lamp/power", "home/bedroom/radio/power", false},
+		{"home/#", "home/bedroom/lamp/power", true},
+		{"home/#", "home", true},
+		{"home/#", "garden/shed/light/power", false},
+		{"#", "home/bedroom/lamp/power", true},
+	}
+	for _, tt := range tests {
+		if got := match(tt.pattern, tt.topic); got != tt.want {
+			t.Errorf("match(%q, %q) = %v, want %v", tt.pattern, tt.topic, got, tt.want)
+		}
+	}
+}
+
+// recorder records the messages a Client receives.
+type recorder struct {
+	mu       sync.Mutex
+	messages []catbus.Message
+}
+
+func (r *recorder) handle(_ catbus.Client, m catbus.Message) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.messages = append(r.messages, m)
+}
+
+func (r *recorder) Messages() []catbus.Message {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]catbus.Message(nil), r.messages...)
+}
+
+// connect connects a Client of b that subscribes to pattern when it connects.
+func connect(t *testing.T, b *Broker, pattern string) (*Client, *recorder) {
+	t.Helper()
+
+	r := &recorder{}
+	connected := make(chan struct{}, 1)
+	c := b.NewClient(catbus.ClientOptions{
+		ConnectHandler: func(c catbus.Client) {
+			if err := c.Subscribe(pattern, r.handle); err != nil {
+				t.Errorf("could not subscribe: %v", err)
+			}
+			connected <- struct{}{}
+		},
+	})
+	go c.Connect()
+	<-connected
+	t.Cleanup(func() { c.Disconnect() })
+	return c, r
+}
+
+func TestRetained(t *testing.T) {
+	b := NewBroker(map[string]string{
+		"home/bedroom/lamp/power": "on",
+		"garden/shed/light/power": "off",
+	})
+	c, r := connect(t, b, "home/#")
+
+	want := []catbus.Message{
+		{Topic: "home/bedroom/lamp/power", Payload: "on", Retained: catbus.Retain},
+	}
+	if got := r.Messages(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v on subscribing, want %v", got, want)
+	}
+
+	if err := c.Publish("home/bedroom/lamp/power", catbus.Retain, "off"); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Publish("home/bedroom/lamp/event", catbus.DontRetain, "pressed"); err != nil {
+		t.Fatal(err)
+	}
+	b.Publish("garden/shed/light/power", catbus.Retain, "")
+
+	// Publishes are sent to the publisher's own subscriptions, as well.
+	want = append(want,
+		catbus.Message{Topic: "home/bedroom/lamp/power", Payload: "off"},
+		catbus.Message{Topic: "home/bedroom/lamp/event", Payload: "pressed"},
+	)
+	if got := r.Messages(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	wantRetained := map[string]string{
+		"home/bedroom/lamp/power": "off",
+	}
+	if got := b.Retained(); !reflect.DeepEqual(got, wantRetained) {
+		t.Errorf("got retained %v, want %v", got, wantRetained)
+	}
+
+	// A later subscriber gets the latest retained payloads.
+	_, r = connect(t, b, "home/+/lamp/power")
+	want = []catbus.Message{
+		{Topic: "home/bedroom/lamp/power", Payload: "off", Retained: catbus.Retain},
+	}
+	if got := r.Messages(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v on subscribing later, want %v", got, want)
+	}
+}
+
+func TestDisconnect(t *testing.T) {
+	b := NewBroker(nil)
+	connected := make(chan struct{})
+	c := b.NewClient(catbus.ClientOptions{
+		ConnectHandler: func(catbus.Client) { close(connected) },
+	})
+
+	if err := c.Publish("home/bedroom/lamp/power", catbus.Retain, "on"); err != ErrNotConnected {
+		t.Errorf("got %v publishing before connecting, want %v", err, ErrNotConnected)
+	}
+
+	returned := make(chan error)
+	go func() { returned <- c.Connect() }()
+	<-connected
+	if err := c.Disconnect(); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-returned; err != nil {
+		t.Errorf("got %v from Connect, want nil", err)
+	}
+	if err := c.Subscribe("home/#", func(catbus.Client, catbus.Message) {}); err != ErrNotConnected {
+		t.Errorf("got %v subscribing after disconnecting, want %v", err, ErrNotConnected)
+	}
+}
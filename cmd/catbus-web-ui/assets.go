@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+)
+
+//go:embed static
+var embedded embed.FS
+
+type (
+	// overlayFS serves files from dir where they exist, and from the built-in static files otherwise.
+	overlayFS struct {
+		dir      http.FileSystem
+		embedded http.FileSystem
+	}
+
+	// assetServer serves static files.
+	// Their URLs include a hash of their contents, so browsers can cache them until they change.
+	assetServer struct {
+		files  http.FileSystem
+		server http.Handler
+
+		mu         sync.Mutex
+		hashByName map[string]string
+	}
+)
+
+// assets returns the static files, overridden by those in dir if it is set.
+func assets(dir string) http.FileSystem {
+	static, err := fs.Sub(embedded, "static")
+	if err != nil {
+		panic(err)
+	}
+	if dir == "" {
+		return http.FS(static)
+	}
+	return overlayFS{
+		dir:      http.Dir(dir),
+		embedded: http.FS(static),
+	}
+}
+
+func (o overlayFS) Open(name string) (http.File, error) {
+	f, err := o.dir.Open(name)
+	if os.IsNotExist(err) {
+		return o.embedded.Open(name)
+	}
+	return f, err
+}
+
+func newAssetServer(files http.FileSystem) *assetServer {
+	return &assetServer{
+		files:      files,
+		server:     http.FileServer(files),
+		hashByName: map[string]string{},
+	}
+}
+
+// URL returns the URL of a static file, such as "icon.svg", with a hash of its contents.
+// Files are hashed once, so changes to the assetsDir need a restart.
+func (a *assetServer) URL(name string) string {
+	if hash := a.hash(name); hash != "" {
+		return "/" + name + "?v=" + hash
+	}
+	return "/" + name
+}
+
+// ServeHTTP serves a static file.
+// Requests for the file's current hash can be cached forever, and everything else must be revalidated with its ETag.
+func (a *assetServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	hash := a.hash(strings.TrimPrefix(r.URL.Path, "/"))
+	if hash != "" {
+		w.Header().Set("ETag", `W/"`+hash+`"`)
+	}
+	if v := r.URL.Query().Get("v"); hash != "" && v == hash {
+		w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+	} else {
+		w.Header().Set("Cache-Control", "no-cache")
+	}
+	a.server.ServeHTTP(w, r)
+}
+
+// hash returns a hash of the contents of a static file, or "" if it can't be read.
+// Only files that exist are remembered, so requests for missing files can't grow the cache.
+func (a *assetServer) hash(name string) string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if hash, ok := a.hashByName[name]; ok {
+		return hash
+	}
+
+	hash := ""
+	if f, err := a.files.Open("/" + name); err == nil {
+		h := sha256.New()
+		if info, err := f.Stat(); err == nil && !info.IsDir() {
+			if _, err := io.Copy(h, f); err == nil {
+				hash = hex.EncodeToString(h.Sum(nil))[:16]
+			}
+		}
+		f.Close()
+	}
+	if hash != "" {
+		a.hashByName[name] = hash
+	}
+	return hash
+}
@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"sync"
+	"time"
+)
+
+// coalescer publishes values one topic at a time, at most once per interval per topic.
+// Values set while a topic is waiting replace each other, so only the latest is published, and the last value set always wins.
+type (
+	coalescer struct {
+		publish  func(topic, value string) error
+		interval time.Duration
+
+		mu             sync.Mutex
+		pendingByTopic map[string]pending
+		running        map[string]bool
+		wg             sync.WaitGroup
+	}
+
+	// pending is a value waiting to be published, and a result channel for every Set waiting on it.
+	pending struct {
+		value   string
+		results []chan error
+	}
+)
+
+func newCoalescer(interval time.Duration, publish func(topic, value string) error) *coalescer {
+	return &coalescer{
+		publish:        publish,
+		interval:       interval,
+		pendingByTopic: map[string]pending{},
+		running:        map[string]bool{},
+	}
+}
+
+// Set queues value to be published to topic, replacing any value still waiting for it.
+// The returned channel receives the result of the publish, or of the publish of whichever later value replaced it.
+func (c *coalescer) Set(topic, value string) <-chan error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	result := make(chan error, 1)
+	p := c.pendingByTopic[topic]
+	c.pendingByTopic[topic] = pending{
+		value:   value,
+		results: append(p.results, result),
+	}
+	if !c.running[topic] {
+		c.running[topic] = true
+		c.wg.Add(1)
+		go c.run(topic)
+	}
+	return result
+}
+
+// Wait waits for every pending value to be published.
+func (c *coalescer) Wait() {
+	c.wg.Wait()
+}
+
+// run publishes the pending values for topic in order until there are none left.
+func (c *coalescer) run(topic string) {
+	defer c.wg.Done()
+	for {
+		c.mu.Lock()
+		p, ok := c.pendingByTopic[topic]
+		if !ok {
+			delete(c.running, topic)
+			c.mu.Unlock()
+			return
+		}
+		delete(c.pendingByTopic, topic)
+		c.mu.Unlock()
+
+		err := c.publish(topic, p.value)
+		for _, result := range p.results {
+			result <- err
+		}
+		time.Sleep(c.interval)
+	}
+}
@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/andybalholm/brotli"
+)
+
+type (
+	// compressWriter compresses a response, if its type is worth compressing.
+	compressWriter struct {
+		http.ResponseWriter
+		encoding string
+
+		encoder     io.WriteCloser
+		wroteHeader bool
+	}
+
+	flusher interface {
+		Flush() error
+	}
+)
+
+// compressedTypes are the content types worth compressing; images other than SVG are compressed already.
+var compressedTypes = []string{
+	"application/javascript",
+	"application/json",
+	"image/svg+xml",
+	"text/",
+}
+
+// compress encodes responses with brotli or gzip, if the client accepts them.
+func compress(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", "Accept-Encoding")
+
+		// Ranges of a compressed response wouldn't match the Content-Range.
+		encoding := acceptedEncoding(r.Header.Get("Accept-Encoding"))
+		if encoding == "" || r.Method == "HEAD" || r.Header.Get("Range") != "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		cw := &compressWriter{ResponseWriter: w, encoding: encoding}
+		defer cw.Close()
+		next.ServeHTTP(cw, r)
+	})
+}
+
+// acceptedEncoding returns the best encoding in an Accept-Encoding header, or "" if none are supported.
+func acceptedEncoding(header string) string {
+	accepted := map[string]bool{}
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(part, ";")
+		name := strings.TrimSpace(fields[0])
+		if len(fields) > 1 && strings.Replace(strings.TrimSpace(fields[1]), " ", "", -1) == "q=0" {
+			continue
+		}
+		accepted[name] = true
+	}
+	for _, encoding := range []string{"br", "gzip"} {
+		if accepted[encoding] {
+			return encoding
+		}
+	}
+	return ""
+}
+
+func (w *compressWriter) WriteHeader(code int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+
+	h := w.Header()
+	if code >= 200 && code != http.StatusNoContent && code != http.StatusNotModified && h.Get("Content-Encoding") == "" && isCompressed(h.Get("Content-Type")) {
+		h.Del("Content-Length")
+		h.Set("Content-Encoding", w.encoding)
+		switch w.encoding {
+		case "br":
+			w.encoder = brotli.NewWriter(w.ResponseWriter)
+		case "gzip":
+			w.encoder = gzip.NewWriter(w.ResponseWriter)
+		}
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *compressWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		if w.Header().Get("Content-Type") == "" {
+			w.Header().Set("Content-Type", http.DetectContentType(b))
+		}
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.encoder == nil {
+		return w.ResponseWriter.Write(b)
+	}
+	return w.encoder.Write(b)
+}
+
+// Flush sends what has been compressed so far, for long-lived responses.
+func (w *compressWriter) Flush() {
+	if f, ok := w.encoder.(flusher); ok {
+		_ = f.Flush()
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (w *compressWriter) Close() error {
+	if w.encoder == nil {
+		return nil
+	}
+	return w.encoder.Close()
+}
+
+func isCompressed(contentType string) bool {
+	for _, t := range compressedTypes {
+		if strings.HasPrefix(contentType, t) {
+			return true
+		}
+	}
+	return false
+}
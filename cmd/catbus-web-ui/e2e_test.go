@@ -0,0 +1,281 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"go.eth.moe/catbus"
+	"go.eth.moe/catbus-web-ui/catbustest"
+	"go.eth.moe/catbus-web-ui/favorites"
+	"go.eth.moe/catbus-web-ui/home"
+	"go.eth.moe/catbus-web-ui/store"
+)
+
+// harness is the server, listening over HTTP, connected to an in-process broker the same way main connects it to a real one.
+type harness struct {
+	t      *testing.T
+	broker *catbustest.Broker
+	server *server
+	http   *httptest.Server
+	client *http.Client
+}
+
+func newHarness(t *testing.T, retained map[string]string) *harness {
+	t.Helper()
+
+	favorites, err := favorites.Open("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	state := store.New(home.Layout{}, nil, 0)
+	status := newBrokerStatus(state.Synced)
+
+	h := &harness{
+		t:      t,
+		broker: catbustest.NewBroker(retained),
+		client: &http.Client{
+			// Show redirects to the test, rather than following them.
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+	client := h.broker.NewClient(catbus.ClientOptions{
+		ConnectHandler: func(_ catbus.Client) {
+			if err := h.server.Connected(); err != nil {
+				t.Errorf("could not subscribe: %v", err)
+			}
+		},
+		DisconnectHandler: func(_ catbus.Client, err error) {
+			h.server.Disconnected(err)
+		},
+	})
+	static := newAssetServer(assets(""))
+	h.server = newServer(client, state, status, favorites, newMetrics(), static, newTemplates(static))
+	h.http = httptest.NewServer(h.server)
+	go client.Connect()
+	t.Cleanup(func() {
+		h.http.Close()
+		h.server.Wait()
+		client.Disconnect()
+	})
+
+	waitForSync(t, h.server)
+	return h
+}
+
+func (h *harness) do(req *http.Request) (*http.Response, string) {
+	h.t.Helper()
+
+	rsp, err := h.client.Do(req)
+	if err != nil {
+		h.t.Fatal(err)
+	}
+	defer rsp.Body.Close()
+	body, err := ioutil.ReadAll(rsp.Body)
+	if err != nil {
+		h.t.Fatal(err)
+	}
+	return rsp, string(body)
+}
+
+func (h *harness) get(path, accept string) (*http.Response, string) {
+	h.t.Helper()
+
+	req, err := http.NewRequest("GET", h.http.URL+path, nil)
+	if err != nil {
+		h.t.Fatal(err)
+	}
+	req.Header.Set("Accept", accept)
+	return h.do(req)
+}
+
+func (h *harness) post(path string, values url.Values, accept string) (*http.Response, string) {
+	h.t.Helper()
+
+	req, err := http.NewRequest("POST", h.http.URL+path, strings.NewReader(values.Encode()))
+	if err != nil {
+		h.t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", accept)
+	return h.do(req)
+}
+
+// home returns the JSON tree of the home, for the query string query.
+func (h *harness) home(query string) map[string]interface{} {
+	h.t.Helper()
+
+	rsp, body := h.get("/home/"+query, "application/json")
+	if rsp.StatusCode != http.StatusOK {
+		h.t.Fatalf("got %v for /home/%v, want %v: %v", rsp.StatusCode, query, http.StatusOK, body)
+	}
+	var tree map[string]interface{}
+	if err := json.Unmarshal([]byte(body), &tree); err != nil {
+		h.t.Fatal(err)
+	}
+	return tree
+}
+
+// valueOf returns the value of the control at zone/device/control in tree, or nil if it isn't there.
+func valueOf(tree map[string]interface{}, zone, device, control string) interface{} {
+	z, _ := tree[zone].(map[string]interface{})
+	d, _ := z[device].(map[string]interface{})
+	c, _ := d[control].(map[string]interface{})
+	return c["value"]
+}
+
+func TestEndToEnd(t *testing.T) {
+	h := newHarness(t, map[string]string{
+		"home/bedroom/lamp/power":              "on",
+		"home/bedroom/lamp/brightness_percent": "40",
+		"home/kitchen/radio/power":             "off",
+	})
+
+	// The retained state arrives when the server subscribes.
+	tree := h.home("")
+	if got := valueOf(tree, "bedroom", "lamp", "power"); got != true {
+		t.Errorf("got bedroom lamp power %v, want true", got)
+	}
+	if got := valueOf(tree, "kitchen", "radio", "power"); got != false {
+		t.Errorf("got kitchen radio power %v, want false", got)
+	}
+
+	rsp, body := h.get("/", "text/html")
+	if rsp.StatusCode != http.StatusOK {
+		t.Fatalf("got %v for /, want %v", rsp.StatusCode, http.StatusOK)
+	}
+	if !strings.Contains(body, "action='/home/kitchen/radio/power'") {
+		t.Errorf("page does not have the kitchen radio")
+	}
+
+	// A write is published to the broker, and echoed back into the state.
+	rsp, body = h.post("/home/bedroom/lamp/brightness_percent", url.Values{"value": {"75"}}, "application/json")
+	if rsp.StatusCode != http.StatusOK {
+		t.Fatalf("got %v writing, want %v: %v", rsp.StatusCode, http.StatusOK, body)
+	}
+	if got := h.broker.Retained()["home/bedroom/lamp/brightness_percent"]; got != "75" {
+		t.Errorf("got retained brightness %q, want %q", got, "75")
+	}
+	if got := valueOf(h.home(""), "bedroom", "lamp", "brightness"); got != 75.0 {
+		t.Errorf("got bedroom lamp brightness %v, want 75", got)
+	}
+
+	// Form submissions from the page go back to it.
+	rsp, _ = h.post("/home/kitchen/radio/power", url.Values{"value": {"on"}}, "text/html")
+	if rsp.StatusCode != http.StatusSeeOther {
+		t.Errorf("got %v for a form, want %v", rsp.StatusCode, http.StatusSeeOther)
+	}
+	if got := h.broker.Retained()["home/kitchen/radio/power"]; got != "on" {
+		t.Errorf("got retained radio power %q, want %q", got, "on")
+	}
+
+	// New devices, and devices going away, are rendered.
+	h.broker.Publish("home/garden/shed/power", catbus.Retain, "on")
+	h.broker.Publish("home/kitchen/radio/power", catbus.Retain, "")
+	_, body = h.get("/", "text/html")
+	if !strings.Contains(body, "action='/home/garden/shed/power'") {
+		t.Errorf("page does not have the new garden shed")
+	}
+	if strings.Contains(body, "action='/home/kitchen/radio/power'") {
+		t.Errorf("page still has the removed kitchen radio")
+	}
+}
+
+func TestEndToEndLongPoll(t *testing.T) {
+	h := newHarness(t, map[string]string{
+		"home/bedroom/lamp/power":  "on",
+		"home/kitchen/radio/power": "off",
+	})
+
+	version := h.home("")["_version"]
+	req, err := http.NewRequest("GET", fmt.Sprintf("%v/home/?since=%v&wait=10s", h.http.URL, version), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Accept", "application/json")
+	changes := make(chan map[string]interface{})
+	go func() {
+		defer close(changes)
+		rsp, err := h.client.Do(req)
+		if err != nil {
+			t.Errorf("could not long poll: %v", err)
+			return
+		}
+		defer rsp.Body.Close()
+		var tree map[string]interface{}
+		if err := json.NewDecoder(rsp.Body).Decode(&tree); err != nil {
+			t.Errorf("could not decode long poll: %v", err)
+			return
+		}
+		changes <- tree
+	}()
+
+	// Give the request time to start waiting.
+	time.Sleep(100 * time.Millisecond)
+	h.broker.Publish("home/bedroom/lamp/power", catbus.Retain, "off")
+
+	select {
+	case tree, ok := <-changes:
+		if !ok {
+			return
+		}
+		if got := valueOf(tree, "bedroom", "lamp", "power"); got != false {
+			t.Errorf("got bedroom lamp power %v, want false", got)
+		}
+		if _, ok := tree["kitchen"]; ok {
+			t.Errorf("got unchanged kitchen in %v", tree)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("long poll did not return after a change")
+	}
+}
+
+func TestEndToEndReconnect(t *testing.T) {
+	h := newHarness(t, map[string]string{
+		"home/bedroom/lamp/power": "on",
+	})
+
+	h.broker.Drop(errors.New("connection reset"))
+	if rsp, _ := h.get("/home/", "application/json"); rsp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("got %v while resyncing, want %v", rsp.StatusCode, http.StatusServiceUnavailable)
+	}
+
+	waitForSync(t, h.server)
+	if got := valueOf(h.home(""), "bedroom", "lamp", "power"); got != true {
+		t.Errorf("got bedroom lamp power %v after reconnecting, want true", got)
+	}
+}
+
+func TestEndToEndSinceAnotherEpoch(t *testing.T) {
+	retained := map[string]string{
+		"home/bedroom/lamp/power":  "on",
+		"home/kitchen/radio/power": "off",
+	}
+	before := newHarness(t, retained).home("")["_version"]
+
+	// After a restart, the version may have come back to the same number, but it is a different state.
+	h := newHarness(t, retained)
+	h.broker.Publish("home/bedroom/lamp/power", catbus.Retain, "off")
+	for _, since := range []interface{}{before, 1} {
+		tree := h.home(fmt.Sprintf("?since=%v", since))
+		if _, ok := tree["_removed"]; ok {
+			t.Errorf("got changes since %v, want the entire home", since)
+		}
+		if got := valueOf(tree, "kitchen", "radio", "power"); got != false {
+			t.Errorf("got kitchen radio power %v since %v, want false", got, since)
+		}
+	}
+}
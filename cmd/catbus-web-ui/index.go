@@ -8,133 +8,687 @@ import (
 	"bytes"
 	"html/template"
 	"log"
-	"strings"
 
 	"go.eth.moe/catbus-web-ui/home"
 )
 
-var (
-	funcs = map[string]interface{}{
-		"controlTmpl": controlTmpl,
-		"title": func(s string) string {
-			return strings.Title(strings.Replace(s, "-", " ", -1))
-		},
+type (
+	// indexPage is the data for the index template.
+	indexPage struct {
+		home.Home
+
+		// Connecting is set until the broker's retained state has arrived.
+		Connecting bool
+
+		// Query is the filter the home has been narrowed by, if any.
+		Query string
+
+		// Favorites are the current user's favorite controls, and IsFavorite is the set of their topics.
+		Favorites  []favorite
+		IsFavorite map[string]bool
+
+		// Theme is the name of the current user's theme, one of Themes.
+		Theme  string
+		Themes []theme
+	}
+
+	favorite struct {
+		Zone    home.Zone
+		Device  home.Device
+		Control home.Control
+	}
+
+	// widget is the data for the control templates.
+	widget struct {
+		// ID is the element ID of the input, unique within the page.
+		ID string
+
+		// Label is the accessible name of the input.
+		Label string
+
+		Control home.Control
 	}
+)
 
-	indexTmpl = template.Must(template.New("index.html").
-			Funcs(funcs).
-			Parse(`<!DOCTYPE html>
-<html lang='en'>
+// The built-in templates, which can be replaced from the templatesDir.
+const (
+	indexSource = `<!DOCTYPE html>
+<html lang='en' data-theme='{{ .Theme }}'>
 <head>
   <meta charset='utf-8'>
   <meta name='viewport' content='width=device-width, initial-scale=1.0'>
 
-  <link rel='icon' href='./icon.svg'>
+  <link rel='icon' href='{{ asset "icon.svg" }}'>
   <!-- add to home screen for Safari on iOS. -->
   <meta name='apple-mobile-web-app-capable' content='yes'>
   <meta name='apple-mobile-web-app-status-bar-style' content='white'>
   <meta name='apple-mobile-web-app-title' content='Home'>
-  <link rel='apple-touch-icon' href='./ios-icon.png'>
+  <link rel='apple-touch-icon' href='{{ asset "ios-icon.png" }}'>
+  <!-- install as an app elsewhere, with an offline view. -->
+  <link rel='manifest' href='{{ asset "manifest.json" }}'>
+  <meta name='theme-color' content='#dd99aa'>
+
+  {{ if .Connecting }}<meta http-equiv='refresh' content='2'>{{ end }}
 
   <title>Home</title>
   <style>
+    /* Themes. */
+    :root {
+        --background: #fff;
+        --foreground: #111;
+        --border: #8886;
+        --accent: #4a8cff;
+        --error: #d00;
+        --dimmed: 0.5;
+    }
     @media (prefers-color-scheme: dark) {
-        body {
-            background: #1f1f1f;
-            color: #ddd;
+        :root[data-theme=auto] {
+            --background: #1f1f1f;
+            --foreground: #ddd;
+            --error: #f77;
         }
     }
-    tr.device > td {
-        padding-bottom: 1em;
+    :root[data-theme=dark] {
+        --background: #1f1f1f;
+        --foreground: #ddd;
+        --error: #f77;
+    }
+    :root[data-theme=high-contrast] {
+        --background: #000;
+        --foreground: #fff;
+        --border: #fff;
+        --accent: #ff0;
+        --error: #ff0;
+        --dimmed: 0.8;
+    }
+
+    body {
+        accent-color: var(--accent);
+        background: var(--background);
+        color: var(--foreground);
+        font-family: system-ui, sans-serif;
+        line-height: 1.4;
+        margin: 0 auto;
+        max-width: 60em;
+        padding: 0 1em 2em;
+    }
+    :focus-visible {
+        outline: 3px solid var(--accent);
+        outline-offset: 2px;
+    }
+    .visually-hidden {
+        clip: rect(0 0 0 0);
+        height: 1px;
+        overflow: hidden;
+        position: absolute;
+        white-space: nowrap;
+        width: 1px;
+    }
+
+    /* Large touch targets. */
+    button, input, select {
+        font-size: 1em;
+    }
+    button, select, input[type=search], input[type=number] {
+        min-height: 44px;
+    }
+    input[type=checkbox] {
+        height: 1.5em;
+        width: 1.5em;
+    }
+    input[type=range] {
+        flex: 2;
+        min-height: 44px;
+        min-width: 8em;
+    }
+
+    .devices {
+        display: grid;
+        gap: 1em;
+        grid-template-columns: repeat(auto-fill, minmax(18em, 1fr));
+    }
+    fieldset {
+        border: none;
+        margin: 0;
+        padding: 0;
+    }
+    fieldset.device {
+        border: 1px solid var(--border);
+        border-radius: 0.5em;
+        padding: 0.5em 1em;
+    }
+    .offline, .stale {
+        opacity: var(--dimmed);
+    }
+    ul.controls {
+        list-style: none;
+        margin: 0;
+        padding: 0;
+    }
+    li.control, li.control > fieldset {
+        align-items: center;
+        display: flex;
+        flex-wrap: wrap;
+        gap: 0.5em;
+        min-height: 44px;
+    }
+    li.control > fieldset, form.control {
+        flex: 1;
+    }
+    form.control {
+        align-items: center;
+        display: flex;
+        flex-wrap: wrap;
+        gap: 0.5em;
+    }
+    li.control label {
+        cursor: pointer;
+        flex: 1;
+        min-width: 6em;
+    }
+    label.toggle {
+        align-items: center;
+        display: flex;
+        gap: 0.5em;
+        min-height: 44px;
+    }
+    form.control.pending {
+        opacity: 0.6;
+    }
+    .error {
+        color: var(--error);
+        flex-basis: 100%;
+    }
+    output {
+        font-variant-numeric: tabular-nums;
+        min-width: 3.5em;
+        text-align: right;
+    }
+    form.bulk, form.filter, form.theme {
+        display: inline-flex;
+        gap: 0.5em;
+        margin: 0 1em 0.5em 0;
+    }
+    form.bulk input[type=number] {
+        width: 4em;
+    }
+    button.favorite {
+        background: none;
+        border: none;
+        color: inherit;
+        cursor: pointer;
+        font-size: 1.2em;
+        min-width: 44px;
     }
   </style>
+  <link rel='stylesheet' href='{{ asset "custom.css" }}'>
 </head>
 <body>
   <h1>Home</h1>
+  {{ if .Connecting }}
+  <p class='connecting' role='status'>Connecting…</p>
+  {{ else }}
+  <form class='filter' method='get' action='/' role='search'>
+    <label for='filter' class='visually-hidden'>Filter zones, devices, and controls</label>
+    <input id='filter' type='search' name='q' value='{{ .Query }}' placeholder='Filter'>
+    <button>Filter</button>
+  </form>
+  {{ if and .Query (not .Zones) (not .Groups) }}
+  <p role='status'>Nothing matches “{{ .Query }}”.</p>
+  {{ end }}
+
+  <nav aria-label='Whole home'>
+    {{ template "bulk" "_all" }}
+    {{ template "bulkBrightness" "_all" }}
+  </nav>
+
+  {{ with .Favorites }}
+  <section class='favorites' aria-labelledby='favorites'>
+    <h2 id='favorites'>Favorites</h2>
+    <ul class='controls'>
+    {{ range . }}
+      {{ $label := printf "%v %v %v" .Zone.DisplayName .Device.DisplayName .Control.Name }}
+      <li class='control {{ .Device.Status }}'>
+        <fieldset {{ if ne .Device.Status "online" }}disabled{{ end }}>
+          {{ controlTmpl .Control "favorite" $label }}
+        </fieldset>
+        <button type='button' class='favorite' data-favorite='{{ .Control.Topic }}' aria-pressed='true' aria-label='Favorite {{ $label }}'>★</button>
+      </li>
+    {{ end }}
+    </ul>
+  </section>
+  {{ end }}
+
+  {{ with .Groups }}
+  <section class='groups' aria-labelledby='groups'>
+    <h2 id='groups'>Groups</h2>
+    <ul class='controls'>
+    {{ range . }}
+      <li class='control'>
+        {{ controlTmpl . "group" .DisplayName }}
+        <small>({{ .State }})</small>
+      </li>
+    {{ end }}
+    </ul>
+  </section>
+  {{ end }}
+
   {{ range .Zones }}
-  <section>
-    <h2>{{ title .Name }}</h2>
-    <table>
+  <section aria-labelledby='zone-{{ .Name }}'>
+    <h2 id='zone-{{ .Name }}'>{{ with .Icon }}<span class='icon' aria-hidden='true'>{{ . }}</span> {{ end }}{{ .DisplayName }}</h2>
+    {{ if .HasControl "power" }}{{ template "bulk" .Name }}{{ end }}
+    {{ if .HasControl "brightness_percent" }}{{ template "bulkBrightness" .Name }}{{ end }}
+    <div class='devices'>
     {{ range .Devices }}
-      <tr class='device'>
-        <td>{{ title .Name }}</td>
-        <td>
-          <table>
-          {{ range .Controls }}
-            <tr>
-              <td>{{ .Name }}</td>
-              <td>{{ controlTmpl . }}</td>
-            </tr>
-          {{ end }}
-          </table>
-        </td>
-      </tr>
+      {{ $device := . }}
+      <fieldset class='device {{ .Status }}' {{ if ne .Status "online" }}disabled{{ end }}>
+        <legend>
+          {{ with .Icon }}<span class='icon' aria-hidden='true'>{{ . }}</span> {{ end }}{{ .DisplayName }}
+          {{ if ne .Status "online" }}<small>({{ .Status }})</small>{{ end }}
+        </legend>
+        <ul class='controls'>
+        {{ range .Controls }}
+          {{ $favorite := index $.IsFavorite .Topic }}
+          <li class='control'>
+            {{ controlTmpl . "control" .Name }}
+            <button type='button' class='favorite' data-favorite='{{ .Topic }}' aria-pressed='{{ $favorite }}' aria-label='Favorite {{ $device.DisplayName }} {{ .Name }}'>{{ if $favorite }}★{{ else }}☆{{ end }}</button>
+          </li>
+        {{ end }}
+        </ul>
+      </fieldset>
     {{ end }}
-    </table>
+    </div>
   </section>
   {{ end }}
+  {{ end }}
+
+  <footer>
+    <form class='theme' method='post' action='/theme'>
+      <label for='theme'>Theme</label>
+      <select id='theme' name='value'>
+      {{ range .Themes }}
+        <option value='{{ .Name }}' {{ if eq .Name $.Theme }}selected{{ end }}>{{ .Label }}</option>
+      {{ end }}
+      </select>
+      <noscript><button>Set</button></noscript>
+    </form>
+  </footer>
 
   <script type='module'>
-    import { addDefaultHooks, loadPage } from '/turbolinks.js';
+    import { addDefaultHooks, diffAndImportTree } from '{{ asset "turbolinks.js" }}';
     addDefaultHooks();
 
+    if ( 'serviceWorker' in navigator ) {
+	navigator.serviceWorker.register( '/sw.js' )
+	    .catch( err => console.log( 'could not register service worker', err ) );
+    }
+
+    // Show groups with some members on as neither checked nor unchecked.
+    const markMixed = () =>
+        document.querySelectorAll( 'input[data-mixed]' ).forEach( e => { e.indeterminate = true; } );
+    markMixed();
+
+    // Reload the page in place.
+    // The filter box is kept as it is, rather than replaced, so typing in it isn't interrupted:
+    // both copies are given what has been typed, so they match and only the one in the page is kept.
+    const parser = new DOMParser();
     const refresh = () =>
-        loadPage( document.location )
-	    .then( () => { console.log( 'refreshed page' ); } );
+	fetch( document.location )
+	    .then( rsp => rsp.text() )
+	    .then( html => {
+		const page = parser.parseFromString( html, 'text/html' );
+		const filter = document.getElementById( 'filter' );
+		const newFilter = page.getElementById( 'filter' );
+		if ( filter && newFilter ) {
+		    filter.setAttribute( 'value', filter.value );
+		    newFilter.setAttribute( 'value', filter.value );
+		}
+		return diffAndImportTree( document.head, page.head )
+		    .then( () => diffAndImportTree( document.body, page.body ) );
+	    } )
+	    .then( () => { markMixed(); console.log( 'refreshed page' ); } );
 
     document.addEventListener( 'focus', refresh );
 
-    const handleInput = e => {
-	const topic = e.target.id;
-	let fd = new FormData();
-	if ( e.target.tagName === 'INPUT' && e.target.type === 'checkbox' ) {
-            if ( e.target.id.endsWith( '/power' ) ) {
-                fd.append( 'value', e.target.checked ? 'on' : 'off' );
-            } else {
-                fd.append( 'value', e.target.checked ? 'yes' : 'no' );
-            }
+    // Filter as you type, keeping the URL in sync so refreshes keep the filter.
+    let filterTimeout;
+    document.addEventListener( 'input', e => {
+	if ( e.target.name !== 'q' ) {
+	    return;
+	}
+	clearTimeout( filterTimeout );
+	filterTimeout = setTimeout( () => {
+	    const url = e.target.value ? '/?q=' + encodeURIComponent( e.target.value ) : '/';
+	    window.history.replaceState( null, null, url );
+	    refresh();
+	}, 200 );
+    } );
+
+    // Controls publish as they change, so their forms are only for when scripts are off.
+    // Bulk actions show any topics they didn't set next to their form.
+    document.addEventListener( 'submit', e => {
+	if ( e.target.classList.contains( 'control' ) ) {
+	    e.preventDefault();
+	    return;
+	}
+	if ( e.target.classList.contains( 'theme' ) ) {
+	    e.preventDefault();
+	    return;
+	}
+	if ( !e.target.classList.contains( 'bulk' ) ) {
+	    return;
+	}
+	e.preventDefault();
+	const fd = new FormData( e.target );
+	if ( e.submitter && e.submitter.name ) {
+	    fd.append( e.submitter.name, e.submitter.value );
+	}
+	// Find the form again once the page has been refreshed, in case it was replaced.
+	const action = e.target.getAttribute( 'action' );
+	const form = () => document.querySelector( 'form.bulk[action="' + action + '"]' ) || e.target;
+	fetch( action, { method: 'POST', body: fd } )
+	    .then( rsp => rsp.json().catch( () => { throw rsp.statusText; } ) )
+	    .then( body => refresh().then( () => showResults( form(), body.results || {} ) ) )
+	    .catch( err => showError( form(), 'Could not set: ' + err ) );
+    } );
+
+    // Show the topics that a bulk action skipped or failed to set, next to its form.
+    const showResults = ( form, results ) => {
+	const topics = Object.keys( results ).sort();
+	const problems = topics
+	    .filter( topic => results[topic] !== 'ok' )
+	    .map( topic => topic.replace( /^home\//, '' ) + ': ' + results[topic] );
+	if ( problems.length === 0 ) {
+	    clearError( form );
+	    return;
+	}
+	const set = topics.length - problems.length;
+	showError( form, 'Set ' + set + ' of ' + topics.length + '. ' + problems.join( '; ' ) );
+    };
+
+    // Keep the visible and spoken values of sliders in sync.
+    const showValue = input => {
+	const text = input.value + ( input.dataset.unit || '' );
+	input.setAttribute( 'aria-valuetext', text );
+	const output = document.querySelector( 'output[for="' + input.id + '"]' );
+	if ( output ) {
+	    output.value = text;
+	}
+    };
+
+    // Writes are shown straight away but marked pending until the broker echoes the value back.
+    // If the write fails or isn't confirmed in time, the control goes back to its last confirmed value, with an error.
+    const confirmTimeoutMs = 5000;
+    const pollMs = 500;
+
+    const valueOf = input => input.type === 'checkbox' ? ( input.checked ? 'on' : 'off' ) : input.value;
+    const setValue = ( input, value ) => {
+	if ( input.type === 'checkbox' ) {
+	    input.checked = value === 'on';
+	    input.indeterminate = false;
 	} else {
-            fd.append( 'value', e.target.value );
+	    input.value = value;
+	}
+	if ( input.type === 'range' ) {
+	    showValue( input );
+	}
+    };
+
+    const setPending = ( input, pending ) => {
+	input.form.classList.toggle( 'pending', pending );
+	input.setAttribute( 'aria-busy', pending );
+    };
+    const showError = ( form, message ) => {
+	let error = form.querySelector( '.error' );
+	if ( !error ) {
+	    error = document.createElement( 'span' );
+	    error.className = 'error';
+	    error.setAttribute( 'role', 'alert' );
+	    form.appendChild( error );
+	}
+	error.textContent = message;
+    };
+    const clearError = form => {
+	const error = form.querySelector( '.error' );
+	if ( error ) {
+	    error.remove();
+	}
+    };
+
+    // settle finishes a write, unless a newer one has been made since.
+    const settle = ( input, value, error ) => {
+	if ( input.dataset.wanted !== value ) {
+	    return;
+	}
+	delete input.dataset.wanted;
+	setPending( input, false );
+	if ( error ) {
+	    console.log( 'could not set ' + input.dataset.topic + ' to ' + value + ': ' + error );
+	    setValue( input, input.dataset.confirmed );
+	    showError( input.form, 'Could not set to ' + value + ': ' + error );
+	    return;
+	}
+	input.dataset.confirmed = value;
+	// Groups change their members, so show them once nothing else is pending.
+	if ( input.dataset.topic.includes( '/_groups/' ) && !document.querySelector( '[data-wanted]' ) ) {
+	    refresh();
+	}
+    };
+
+    const findControl = ( tree, topic ) => {
+	for ( const zone of Object.values( tree ) ) {
+	    for ( const device of Object.values( zone ) ) {
+		for ( const control of Object.values( device ) ) {
+		    if ( control && control.topic === topic ) {
+			return control;
+		    }
+		}
+	    }
 	}
-	console.log( 'pushing ' + e.target.value + ' to ' + topic );
-	fetch( '/' + topic, { method: 'POST', body: fd } ).then( () => console.log( 'updated' ) );
     };
-    document.addEventListener( 'change', e => { handleInput( e ); refresh(); } );
+    const matches = ( control, value ) => {
+	if ( control.state ) {
+	    return control.state === ( value === 'on' ? 'all' : 'none' );
+	}
+	if ( typeof control.value === 'boolean' ) {
+	    return control.value === ( value === 'on' );
+	}
+	return String( control.value ) === value;
+    };
+
+    // confirm polls the home until the control has the value, or gives up.
+    const confirm = ( input, value ) => {
+	const deadline = Date.now() + confirmTimeoutMs;
+	const poll = () => {
+	    if ( input.dataset.wanted !== value ) {
+		return;
+	    }
+	    if ( Date.now() > deadline ) {
+		settle( input, value, 'no response from the device' );
+		return;
+	    }
+	    fetch( '/home/', { headers: { Accept: 'application/json' } } )
+		.then( rsp => rsp.ok ? rsp.json() : {} )
+		.then( tree => {
+		    const control = findControl( tree, input.dataset.topic );
+		    if ( control && matches( control, value ) ) {
+			settle( input, value );
+		    } else {
+			setTimeout( poll, pollMs );
+		    }
+		} )
+		.catch( () => setTimeout( poll, pollMs ) );
+	};
+	poll();
+    };
+
+    const handleInput = e => {
+	const input = e.target;
+	const topic = input.dataset.topic;
+	if ( !topic ) {
+	    return;
+	}
+	if ( input.type === 'range' ) {
+	    showValue( input );
+	}
+	const value = valueOf( input );
+	if ( value === input.dataset.wanted ) {
+	    return;
+	}
+	input.dataset.wanted = value;
+	setPending( input, true );
+	clearError( input.form );
+
+	const fd = new FormData();
+	fd.append( 'value', value );
+	throttle( topic, () => {
+	    console.log( 'pushing ' + value + ' to ' + topic );
+	    return fetch( '/' + topic, { method: 'POST', body: fd } )
+		.then( rsp => rsp.json().then( body => {
+		    if ( !rsp.ok ) {
+			throw body.error || rsp.statusText;
+		    }
+		    confirm( input, value );
+		} ) )
+		.catch( err => settle( input, value, String( err ) ) );
+	} );
+    };
+
+    // Send at most one write per topic at a time, and no more than every 100ms.
+    // Writes made meanwhile replace each other, so the last one is always sent.
+    const throttleMs = 100;
+    const pendingByTopic = {};
+    const busyTopics = new Set();
+    const throttle = ( topic, send ) => {
+	pendingByTopic[topic] = send;
+	if ( busyTopics.has( topic ) ) {
+	    return;
+	}
+	busyTopics.add( topic );
+	const next = () => {
+	    const send = pendingByTopic[topic];
+	    if ( !send ) {
+		busyTopics.delete( topic );
+		return;
+	    }
+	    delete pendingByTopic[topic];
+	    Promise.all( [
+		send(),
+		new Promise( resolve => setTimeout( resolve, throttleMs ) ),
+	    ] ).then( next );
+	};
+	next();
+    };
+    document.addEventListener( 'change', handleInput );
     document.addEventListener( 'input', handleInput );
+
+    // Themes apply straight away, and are remembered for next time.
+    document.addEventListener( 'change', e => {
+	if ( e.target.id !== 'theme' ) {
+	    return;
+	}
+	document.documentElement.dataset.theme = e.target.value;
+	const fd = new FormData();
+	fd.append( 'value', e.target.value );
+	fetch( '/theme', { method: 'POST', body: fd } );
+    } );
+
+    document.addEventListener( 'click', e => {
+	const button = e.target.closest( '[data-favorite]' );
+	if ( !button ) {
+	    return;
+	}
+	const method = button.getAttribute( 'aria-pressed' ) === 'true' ? 'DELETE' : 'PUT';
+	fetch( '/favorites/' + button.dataset.favorite, { method } ).then( refresh );
+    } );
   </script>
+  <script type='module' src='{{ asset "custom.js" }}'></script>
 </body>
-</html>`))
-
-	enumTmpl = template.Must(template.New("enum").Parse(`
-{{ $value := .Value }}
-<select id='{{ .Topic }}'>
-{{ range .Values }}
-  <option {{ if eq $value . }}selected{{ end}}>{{ . }}</option>
+</html>
+{{ define "bulk" }}
+<form class='bulk' method='post' action='/home/{{ . }}/_all/power'>
+  <button name='value' value='off'>All off</button>
+  <button name='value' value='on'>All on</button>
+</form>
 {{ end }}
-</select>`))
+{{ define "bulkBrightness" }}
+<form class='bulk' method='post' action='/home/{{ . }}/_all/brightness_percent'>
+  <label for='brightness-{{ . }}' class='visually-hidden'>Brightness percent</label>
+  <input id='brightness-{{ . }}' type='number' name='value' min='0' max='100' value='50'>
+  <button>Set brightness</button>
+</form>
+{{ end }}`
 
-	rangeTmpl = template.Must(template.New("range").Parse(
-		"<input id='{{ .Topic }}' type='range' min='{{ .Min }}' max='{{ .Max }}' value='{{ .Value }}'>",
-	))
+	// The control templates are real forms, so they work without JavaScript.
+	// A checkbox is followed by a hidden "off", so an unchecked box still sends a value, and a checked one sends "on" first.
+	enumSource = `
+{{ $value := .Control.Value }}
+<form class='control' method='post' action='/{{ .Control.Topic }}'>
+  <label for='{{ .ID }}'>{{ .Label }}</label>
+  <select id='{{ .ID }}' name='value' data-topic='{{ .Control.Topic }}' data-confirmed='{{ .Control.Value }}'>
+  {{ range .Control.Values }}
+    <option {{ if eq $value . }}selected{{ end}}>{{ . }}</option>
+  {{ end }}
+  </select>
+  <noscript><button>Set</button></noscript>
+</form>`
+
+	rangeSource = `
+<form class='control' method='post' action='/{{ .Control.Topic }}'>
+  <label for='{{ .ID }}'>{{ .Label }}</label>
+  <input id='{{ .ID }}' name='value' data-topic='{{ .Control.Topic }}' data-confirmed='{{ .Control.Value }}' data-unit='{{ .Control.Unit }}' type='range'
+    min='{{ .Control.Min }}' max='{{ .Control.Max }}' value='{{ .Control.Value }}'
+    aria-valuetext='{{ .Control.Value }}{{ .Control.Unit }}'>
+  <output for='{{ .ID }}'>{{ .Control.Value }}{{ .Control.Unit }}</output>
+  <noscript><button>Set</button></noscript>
+</form>`
 
-	toggleTmpl = template.Must(template.New("toggle").Parse(
-		"<input id='{{ .Topic }}' type='checkbox' {{ if .Value }}checked{{ end }}>",
-	))
+	toggleSource = `
+<form class='control' method='post' action='/{{ .Control.Topic }}'>
+  <label class='toggle' for='{{ .ID }}'>
+    <input id='{{ .ID }}' name='value' value='on' data-topic='{{ .Control.Topic }}' data-confirmed='{{ if .Control.Value }}on{{ else }}off{{ end }}' type='checkbox' role='switch' {{ if .Control.Value }}checked{{ end }}>
+    <input type='hidden' name='value' value='off'>
+    <span>{{ .Label }}</span>
+  </label>
+  <noscript><button>Set</button></noscript>
+</form>`
+
+	groupSource = `
+<form class='control' method='post' action='/{{ .Control.Topic }}'>
+  <label class='toggle' for='{{ .ID }}'>
+    <input id='{{ .ID }}' name='value' value='on' data-topic='{{ .Control.Topic }}' data-confirmed='{{ if .Control.Value }}on{{ else }}off{{ end }}' type='checkbox' {{ if .Control.Value }}checked{{ end }} {{ if .Control.Mixed }}data-mixed{{ end }}>
+    <input type='hidden' name='value' value='off'>
+    <span>{{ .Label }}</span>
+  </label>
+  <noscript><button>Set</button></noscript>
+</form>`
 )
 
-func controlTmpl(control home.Control) template.HTML {
+// controlTmpl renders a control's input and its label.
+// The input's ID is its topic with a prefix, so the same control can be on the page more than once.
+func (t *templates) controlTmpl(control home.Control, idPrefix, label string) template.HTML {
+	data := widget{
+		ID:      idPrefix + "-" + control.Topic(),
+		Label:   label,
+		Control: control,
+	}
+
 	var w bytes.Buffer
-	switch control := control.(type) {
+	switch control.(type) {
 	case *home.Enum:
-		if err := enumTmpl.Execute(&w, control); err != nil {
+		if err := t.enumTmpl.Execute(&w, data); err != nil {
 			log.Printf("could not fill template: %v", err)
 		}
 	case *home.Range:
-		if err := rangeTmpl.Execute(&w, control); err != nil {
+		if err := t.rangeTmpl.Execute(&w, data); err != nil {
 			log.Printf("could not fill template: %v", err)
 		}
 	case *home.Toggle:
-		if err := toggleTmpl.Execute(&w, control); err != nil {
+		if err := t.toggleTmpl.Execute(&w, data); err != nil {
+			log.Printf("could not fill template: %v", err)
+		}
+	case *home.Group:
+		if err := t.groupTmpl.Execute(&w, data); err != nil {
 			log.Printf("could not fill template: %v", err)
 		}
 	default:
@@ -142,3 +696,41 @@ func controlTmpl(control home.Control) template.HTML {
 	}
 	return template.HTML(w.String())
 }
+
+// favoritesOf returns the controls in h with the given topics, skipping any that no longer exist.
+func favoritesOf(h home.Home, topics []string) []favorite {
+	var favorites []favorite
+	for _, topic := range topics {
+		if f, ok := lookup(h, topic); ok {
+			favorites = append(favorites, f)
+		}
+	}
+	return favorites
+}
+
+// controlOf returns the control in h with the given topic.
+func controlOf(h home.Home, topic string) (home.Control, bool) {
+	f, ok := lookup(h, topic)
+	return f.Control, ok
+}
+
+// lookup returns the control in h with the given topic, and its zone and device.
+func lookup(h home.Home, topic string) (favorite, bool) {
+	zoneName, deviceName, controlName, ok := home.ParseTopic(topic)
+	if !ok {
+		return favorite{}, false
+	}
+	zone, ok := h.Zone(zoneName)
+	if !ok {
+		return favorite{}, false
+	}
+	device, ok := zone.Device(deviceName)
+	if !ok {
+		return favorite{}, false
+	}
+	control, ok := device.Control(controlName)
+	if !ok {
+		return favorite{}, false
+	}
+	return favorite{zone, device, control}, true
+}
@@ -2,55 +2,79 @@
 //
 // SPDX-License-Identifier: MIT
 
-//go:generate go run github.com/rakyll/statik -src=static
-
 // Binary catbus-web-ui is a web UI for Catbus.
 package main
 
 import (
-	"encoding/json"
+	"context"
 	"flag"
 	"fmt"
 	"log"
 	"net"
 	"net/http"
 	"os"
+	"os/signal"
 	"sync"
+	"syscall"
+	"time"
 
-	"github.com/gorilla/mux"
-	"github.com/rakyll/statik/fs"
 	"go.eth.moe/catbus"
 	"go.eth.moe/catbus-web-ui/config"
-	"go.eth.moe/catbus-web-ui/home"
-
-	_ "go.eth.moe/catbus-web-ui/cmd/catbus-web-ui/statik"
+	"go.eth.moe/catbus-web-ui/favorites"
+	"go.eth.moe/catbus-web-ui/store"
+	"go.eth.moe/catbus-web-ui/systemd"
 )
 
 var (
 	port   = flag.Uint("port", 0, "port to listen on")
 	socket = flag.String("socket", "", "path to socket to listen to")
 
-	configPath = flag.String("config-path", "", "path to config.json")
+	configPath  = flag.String("config-path", "", "path to config.json, config.yaml, or config.toml (optional if CATBUS_WEB_UI_* environment variables are set)")
+	checkConfig = flag.Bool("check-config", false, "validate the config and exit")
+
+	shutdownTimeout = flag.Duration("shutdown-timeout", 10*time.Second, "how long to wait for requests and publishes to finish when shutting down")
 )
 
 func main() {
 	flag.Parse()
 
-	if *configPath == "" {
-		log.Fatal("must set -config-path")
+	config, err := config.Load(*configPath, os.LookupEnv)
+	var static *assetServer
+	var templates *templates
+	if err == nil {
+		static = newAssetServer(assets(config.AssetsDir))
+		templates, err = loadTemplates(config.TemplatesDir, static)
+	}
+	if *checkConfig {
+		checkConfigAndExit(*configPath, err)
 	}
-	config, err := config.ParseFile(*configPath)
 	if err != nil {
 		log.Fatalf("could not read config %q: %v", *configPath, err)
 	}
 
-	if (*port == 0) == (*socket == "") {
-		log.Fatal("must set -socket XOR -port")
+	favorites, err := favorites.Open(config.FavoritesPath)
+	if err != nil {
+		log.Fatalf("could not open favorites %q: %v", config.FavoritesPath, err)
+	}
+
+	listeners, err := systemd.Listeners()
+	if err != nil {
+		log.Fatalf("could not use sockets from systemd: %v", err)
 	}
 	var conn net.Listener
-	if *port != 0 {
+	switch {
+	case len(listeners) == 1:
+		if *port != 0 || *socket != "" {
+			log.Fatal("must not set -socket or -port with systemd socket activation")
+		}
+		conn = listeners[0]
+	case len(listeners) > 1:
+		log.Fatalf("systemd passed %d sockets, want 1", len(listeners))
+	case (*port == 0) == (*socket == ""):
+		log.Fatal("must set -socket XOR -port")
+	case *port != 0:
 		conn, err = net.Listen("tcp", fmt.Sprintf(":%v", *port))
-	} else {
+	default:
 		_ = os.Remove(*socket)
 		conn, err = net.Listen("unix", *socket)
 		_ = os.Chmod(*socket, 0660)
@@ -60,128 +84,139 @@ func main() {
 	}
 	defer conn.Close()
 
-	payloadByTopic := map[string]string{}
-	payloadByTopicMu := sync.RWMutex{}
+	// shuttingDown is cancelled when we receive SIGINT or SIGTERM.
+	// It is the base context of every HTTP request, so long-lived requests can finish cleanly.
+	shuttingDown, shutDown := context.WithCancel(context.Background())
+	defer shutDown()
+
+	state := store.New(config.Layout, config.Groups, config.StaleAfter)
+
+	var notifyReady sync.Once
+	status := newBrokerStatus(func() {
+		log.Printf("received retained state from broker %q", config.BrokerURI)
+		state.Synced()
+
+		notifyReady.Do(func() {
+			if err := systemd.Notify("READY=1"); err != nil {
+				log.Printf("could not notify systemd: %v", err)
+			}
+		})
+	})
+
+	// The handlers only run once the broker is connected, after s is set.
+	var s *server
 	broker := catbus.NewClient(config.BrokerURI, catbus.ClientOptions{
-		ConnectHandler: func(broker catbus.Client) {
+		ConnectHandler: func(_ catbus.Client) {
 			log.Printf("connected to broker %q", config.BrokerURI)
-			broker.Subscribe("home/#", func(_ catbus.Client, m catbus.Message) {
-				payloadByTopicMu.Lock()
-				defer payloadByTopicMu.Unlock()
-
-				payloadByTopic[m.Topic] = m.Payload
-				if m.Payload == "" {
-					delete(payloadByTopic, m.Topic)
-				}
-			})
+			if err := s.Connected(); err != nil {
+				log.Printf("could not subscribe to broker %q: %v", config.BrokerURI, err)
+			}
 		},
 		DisconnectHandler: func(_ catbus.Client, err error) {
-			log.Printf("connected to broker %q: %v", config.BrokerURI, err)
+			s.Disconnected(err)
+			log.Printf("disconnected from broker %q: %v", config.BrokerURI, err)
 		},
 	})
+	s = newServer(broker, state, status, favorites, newMetrics(), static, templates)
 	go func() {
-		if err := broker.Connect(); err != nil {
+		if err := broker.Connect(); err != nil && shuttingDown.Err() == nil {
 			log.Fatalf("could not connect to broker %q: %v", config.BrokerURI, err)
 		}
 	}()
 
-	m := mux.NewRouter()
-	m.NotFoundHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		msg := fmt.Sprintf("not found: %v %v %v", r.Method, r.URL, r.Form)
-		if r.URL.Path != "/favicon.ico" {
-			log.Print(msg)
-		}
-		http.Error(w, msg, http.StatusNotFound)
-	})
-
-	// Return the tree of zones/devices/controls under home/{path} as JSON.
-	// For example,
-	// 	GET /home/ => the entire home.
-	// 	GET /home/bedroom => everything under home/bedroom.
-	// TODO: maybe actually do the prefix thing?
-	m.Path("/home/").
-		Methods("GET").
-		Headers("Accept", "application/json").
-		HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			payloadByTopicMu.RLock()
-			defer payloadByTopicMu.RUnlock()
-
-			h := home.OfValuesByTopic(payloadByTopic)
-
-			rsp := map[string]interface{}{}
-			for _, zone := range h.Zones() {
-				rspZone := map[string]interface{}{}
-				for _, device := range zone.Devices() {
-					rspDevice := map[string]interface{}{}
-					for _, control := range device.Controls() {
-						rspControl := map[string]interface{}{}
-						switch control := control.(type) {
-						case *home.Enum:
-							rspControl["value"] = control.Value
-							rspControl["values"] = control.Values
-						case *home.Range:
-							rspControl["value"] = control.Value
-							rspControl["min"] = control.Min
-							rspControl["max"] = control.Max
-						case *home.Toggle:
-							rspControl["value"] = control.Value
-						default:
-							panic("unknown control type")
-						}
-						rspDevice[control.Name()] = rspControl
+	if interval := systemd.WatchdogInterval(); interval > 0 {
+		go func() {
+			ticker := time.NewTicker(interval / 2)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ticker.C:
+					if !status.IsConnected() {
+						continue
+					}
+					if err := systemd.Notify("WATCHDOG=1"); err != nil {
+						log.Printf("could not notify systemd watchdog: %v", err)
 					}
-					rspZone[device.Name()] = rspDevice
+				case <-shuttingDown.Done():
+					return
 				}
-				rsp[zone.Name()] = rspZone
 			}
+		}()
+	}
 
-			bytes, err := json.Marshal(rsp)
-			if err != nil {
-				panic(err)
-			}
-			w.Write(bytes)
-		})
+	srv := &http.Server{
+		Handler: s,
+		BaseContext: func(_ net.Listener) context.Context {
+			return shuttingDown
+		},
+	}
+	serveErrs := make(chan error, 1)
+	go func() {
+		log.Printf("starting HTTP server on %v", conn.Addr())
+		serveErrs <- srv.Serve(conn)
+	}()
 
-	m.Path("/").
-		Methods("GET").
-		HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			payloadByTopicMu.RLock()
-			defer payloadByTopicMu.RUnlock()
-			h := home.OfValuesByTopic(payloadByTopic)
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	select {
+	case sig := <-signals:
+		log.Printf("received %v, shutting down", sig)
+	case err := <-serveErrs:
+		log.Fatalf("HTTP server failed: %v", err)
+	}
+	signal.Stop(signals)
+	_ = systemd.Notify("STOPPING=1")
 
-			if err := indexTmpl.Execute(w, h); err != nil {
-				log.Printf("could not template: %v", err)
-			}
-		})
+	ctx, cancel := context.WithTimeout(context.Background(), *shutdownTimeout)
+	defer cancel()
 
-	m.Path("/home/{zone}/{device}/{control}").
-		Methods("POST").
-		HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			topic := r.URL.Path[1:]
-			value := r.FormValue("value")
-			if value == "" {
-				return
-			}
+	// Stop accepting connections and tell long-lived requests to finish, then wait for the rest.
+	shutDown()
+	if err := srv.Shutdown(ctx); err != nil {
+		log.Printf("could not drain HTTP connections: %v", err)
+	}
 
-			payloadByTopicMu.Lock()
-			defer payloadByTopicMu.Unlock()
+	// No more handlers are running, so no more publishes can start.
+	drained := make(chan struct{})
+	go func() {
+		s.Wait()
+		close(drained)
+	}()
+	select {
+	case <-drained:
+	case <-ctx.Done():
+		log.Printf("could not drain publishes: %v", ctx.Err())
+	}
 
-			if _, ok := payloadByTopic[topic]; ok {
-				payloadByTopic[topic] = value // TODO: Can this de-sync from the broker?
-				go broker.Publish(topic, catbus.Retain, value)
-			}
-		})
+	if err := broker.Disconnect(); err != nil {
+		log.Printf("could not disconnect from broker %q: %v", config.BrokerURI, err)
+	}
 
-	statikFS, err := fs.New()
-	if err != nil {
-		panic(err)
+	if *socket != "" {
+		if err := os.Remove(*socket); err != nil && !os.IsNotExist(err) {
+			log.Printf("could not remove socket %q: %v", *socket, err)
+		}
 	}
-	m.PathPrefix("/").
-		Methods("GET").
-		Handler(http.FileServer(statikFS))
+	log.Print("shut down")
+}
 
-	log.Printf("starting HTTP server on %v", conn.Addr())
-	if err := http.Serve(conn, m); err != nil {
-		log.Fatalf("HTTP server failed: %v", err)
+// checkConfigAndExit reports the result of parsing the config for -check-config.
+func checkConfigAndExit(path string, err error) {
+	name := fmt.Sprintf("%q", path)
+	if path == "" {
+		name = "from environment"
+	}
+	if err == nil {
+		fmt.Printf("config %v is valid\n", name)
+		os.Exit(0)
+	}
+	if err, ok := err.(*config.ValidationError); ok {
+		fmt.Fprintf(os.Stderr, "config %v is invalid:\n", name)
+		for _, p := range err.Problems {
+			fmt.Fprintf(os.Stderr, "\t%v\n", p)
+		}
+		os.Exit(1)
 	}
+	fmt.Fprintf(os.Stderr, "could not read config %v: %v\n", name, err)
+	os.Exit(1)
 }
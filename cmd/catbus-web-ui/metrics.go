@@ -0,0 +1,250 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+	"go.eth.moe/catbus-web-ui/home"
+)
+
+type (
+	// metrics collects counters and histograms for /metrics, in the Prometheus text format.
+	metrics struct {
+		mu                sync.Mutex
+		requests          map[requestKey]uint64
+		durations         map[string]*histogram
+		publishes         map[string]uint64
+		brokerConnects    uint64
+		brokerDisconnects uint64
+		messages          map[string]uint64
+	}
+
+	requestKey struct {
+		route  string
+		method string
+		code   int
+	}
+
+	histogram struct {
+		counts []uint64
+		sum    float64
+		count  uint64
+	}
+
+	statusRecorder struct {
+		http.ResponseWriter
+		code int
+	}
+)
+
+// durationBuckets are the upper bounds of the request latency histogram, in seconds.
+var durationBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+func newMetrics() *metrics {
+	return &metrics{
+		requests:  map[requestKey]uint64{},
+		durations: map[string]*histogram{},
+		publishes: map[string]uint64{},
+		messages:  map[string]uint64{},
+	}
+}
+
+// Middleware records the count and latency of requests, by route.
+func (m *metrics) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route := "unknown"
+		if current := mux.CurrentRoute(r); current != nil {
+			if tmpl, err := current.GetPathTemplate(); err == nil {
+				route = tmpl
+			}
+		}
+
+		start := time.Now()
+		rec := &statusRecorder{ResponseWriter: w, code: http.StatusOK}
+		next.ServeHTTP(rec, r)
+		elapsed := time.Since(start)
+
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		m.requests[requestKey{route, r.Method, rec.code}]++
+		if _, ok := m.durations[route]; !ok {
+			m.durations[route] = &histogram{counts: make([]uint64, len(durationBuckets))}
+		}
+		m.durations[route].observe(elapsed.Seconds())
+	})
+}
+
+func (m *metrics) Published(err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err != nil {
+		m.publishes["failure"]++
+	} else {
+		m.publishes["success"]++
+	}
+}
+
+func (m *metrics) BrokerConnected() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.brokerConnects++
+}
+func (m *metrics) BrokerDisconnected() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.brokerDisconnects++
+}
+
+// MessageReceived counts a message by its topic prefix, e.g. "home/bedroom".
+func (m *metrics) MessageReceived(topic string) {
+	parts := strings.SplitN(topic, "/", 3)
+	if len(parts) > 2 {
+		parts = parts[:2]
+	}
+	prefix := strings.Join(parts, "/")
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.messages[prefix]++
+}
+
+// WriteTo writes every metric, plus the size of the topic cache and the value of each numeric control in h.
+func (m *metrics) WriteTo(w io.Writer, topics int, h home.Home) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	writeHeader(w, "catbus_web_ui_http_requests_total", "counter", "HTTP requests, by route, method, and status code.")
+	var keys []requestKey
+	for key := range m.requests {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].route != keys[j].route {
+			return keys[i].route < keys[j].route
+		}
+		if keys[i].method != keys[j].method {
+			return keys[i].method < keys[j].method
+		}
+		return keys[i].code < keys[j].code
+	})
+	for _, key := range keys {
+		fmt.Fprintf(w, "catbus_web_ui_http_requests_total{route=%v,method=%v,code=\"%d\"} %d\n", quote(key.route), quote(key.method), key.code, m.requests[key])
+	}
+
+	writeHeader(w, "catbus_web_ui_http_request_duration_seconds", "histogram", "HTTP request latency, by route.")
+	for _, route := range sortedRoutes(m.durations) {
+		hist := m.durations[route]
+		for i, le := range durationBuckets {
+			fmt.Fprintf(w, "catbus_web_ui_http_request_duration_seconds_bucket{route=%v,le=\"%v\"} %d\n", quote(route), le, hist.counts[i])
+		}
+		fmt.Fprintf(w, "catbus_web_ui_http_request_duration_seconds_bucket{route=%v,le=\"+Inf\"} %d\n", quote(route), hist.count)
+		fmt.Fprintf(w, "catbus_web_ui_http_request_duration_seconds_sum{route=%v} %v\n", quote(route), hist.sum)
+		fmt.Fprintf(w, "catbus_web_ui_http_request_duration_seconds_count{route=%v} %d\n", quote(route), hist.count)
+	}
+
+	writeHeader(w, "catbus_web_ui_publishes_total", "counter", "Publishes to the broker, by result.")
+	for _, result := range []string{"success", "failure"} {
+		fmt.Fprintf(w, "catbus_web_ui_publishes_total{result=%v} %d\n", quote(result), m.publishes[result])
+	}
+
+	writeHeader(w, "catbus_web_ui_broker_connects_total", "counter", "Connections to the broker.")
+	fmt.Fprintf(w, "catbus_web_ui_broker_connects_total %d\n", m.brokerConnects)
+	writeHeader(w, "catbus_web_ui_broker_disconnects_total", "counter", "Disconnections from the broker.")
+	fmt.Fprintf(w, "catbus_web_ui_broker_disconnects_total %d\n", m.brokerDisconnects)
+
+	writeHeader(w, "catbus_web_ui_messages_received_total", "counter", "Messages received from the broker, by topic prefix.")
+	for _, prefix := range sortedCounters(m.messages) {
+		fmt.Fprintf(w, "catbus_web_ui_messages_received_total{prefix=%v} %d\n", quote(prefix), m.messages[prefix])
+	}
+
+	writeHeader(w, "catbus_web_ui_cached_topics", "gauge", "Topics with a retained value in the cache.")
+	fmt.Fprintf(w, "catbus_web_ui_cached_topics %d\n", topics)
+
+	writeHeader(w, "catbus_web_ui_control_value", "gauge", "The value of each Range control, and each Toggle control as 0 or 1.")
+	for _, zone := range h.Zones() {
+		for _, device := range zone.Devices() {
+			for _, control := range device.Controls() {
+				var value int
+				switch control := control.(type) {
+				case *home.Range:
+					value = control.Value
+				case *home.Toggle:
+					if control.Value {
+						value = 1
+					}
+				default:
+					continue
+				}
+				// The topic's name for the control keeps its unit, so e.g. hue_percent and hue_degrees stay apart.
+				_, _, name, ok := home.ParseTopic(control.Topic())
+				if !ok {
+					continue
+				}
+				fmt.Fprintf(w, "catbus_web_ui_control_value{zone=%v,device=%v,control=%v} %d\n", quote(zone.Name()), quote(device.Name()), quote(name), value)
+			}
+		}
+	}
+}
+
+func (h *histogram) observe(v float64) {
+	for i, le := range durationBuckets {
+		if v <= le {
+			h.counts[i]++
+		}
+	}
+	h.sum += v
+	h.count++
+}
+
+func (r *statusRecorder) WriteHeader(code int) {
+	r.code = code
+	r.ResponseWriter.WriteHeader(code)
+}
+
+// Flush lets long-lived handlers flush through the recorder.
+func (r *statusRecorder) Flush() {
+	if f, ok := r.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func writeHeader(w io.Writer, name, kind, help string) {
+	fmt.Fprintf(w, "# HELP %v %v\n", name, help)
+	fmt.Fprintf(w, "# TYPE %v %v\n", name, kind)
+}
+
+// quote quotes a Prometheus label value.
+func quote(s string) string {
+	s = strings.Replace(s, `\`, `\\`, -1)
+	s = strings.Replace(s, `"`, `\"`, -1)
+	s = strings.Replace(s, "\n", `\n`, -1)
+	return `"` + s + `"`
+}
+
+func sortedCounters(m map[string]uint64) []string {
+	var keys []string
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedRoutes(m map[string]*histogram) []string {
+	var keys []string
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,684 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+	"go.eth.moe/catbus"
+	"go.eth.moe/catbus-web-ui/favorites"
+	"go.eth.moe/catbus-web-ui/home"
+	"go.eth.moe/catbus-web-ui/store"
+)
+
+// retryAfter is the Retry-After for requests made before the broker's state has arrived, in seconds.
+const retryAfter = "2"
+
+// publishInterval is the most often a single control is published to, so dragging a slider doesn't flood the broker.
+const publishInterval = 100 * time.Millisecond
+
+type (
+	// broker is the part of catbus.Client that the server uses, so tests can give it a fake.
+	broker interface {
+		Publish(topic string, retention catbus.Retention, payload string) error
+		Subscribe(topic string, f catbus.MessageHandler) error
+	}
+
+	// server is the web UI and JSON API for the home held in state, writing to the broker.
+	server struct {
+		broker    broker
+		state     *store.Store
+		status    *brokerStatus
+		favorites *favorites.Store
+		metrics   *metrics
+		static    *assetServer
+		templates *templates
+
+		// publishes tracks in-flight publishes, so they can be drained on shutdown.
+		publishes sync.WaitGroup
+		coalesced *coalescer
+
+		handler http.Handler
+	}
+)
+
+func newServer(broker broker, state *store.Store, status *brokerStatus, favorites *favorites.Store, metrics *metrics, static *assetServer, templates *templates) *server {
+	s := &server{
+		broker:    broker,
+		state:     state,
+		status:    status,
+		favorites: favorites,
+		metrics:   metrics,
+		static:    static,
+		templates: templates,
+	}
+	s.coalesced = newCoalescer(publishInterval, s.publish)
+
+	m := mux.NewRouter()
+	m.Use(metrics.Middleware, compress)
+	// Requests that match no route skip the router's middleware, so they are counted here.
+	m.NotFoundHandler = metrics.Middleware(http.HandlerFunc(s.handleNotFound))
+	m.MethodNotAllowedHandler = metrics.Middleware(http.HandlerFunc(s.handleMethodNotAllowed))
+
+	m.Path("/home/").
+		Methods("GET").
+		Headers("Accept", "application/json").
+		HandlerFunc(s.handleHome)
+
+	m.Path("/healthz").
+		Methods("GET").
+		HandlerFunc(s.handleHealthz)
+
+	m.Path("/readyz").
+		Methods("GET").
+		HandlerFunc(s.handleReadyz)
+
+	m.Path("/metrics").
+		Methods("GET").
+		HandlerFunc(s.handleMetrics)
+
+	m.Path("/").
+		Methods("GET").
+		HandlerFunc(s.handleIndex)
+
+	m.Path("/theme").
+		Methods("POST").
+		HandlerFunc(s.handleTheme)
+
+	m.Path("/favorites/").
+		Methods("GET").
+		HandlerFunc(s.handleFavorites)
+
+	m.Path("/favorites/{topic:home/.+}").
+		Methods("PUT", "DELETE").
+		HandlerFunc(s.handleFavorite)
+
+	m.Path("/home/{zone}/" + home.All + "/{control}").
+		Methods("POST").
+		HandlerFunc(s.handleBulk)
+
+	m.Path("/home/{zone}/{device}/{control}").
+		Methods("POST").
+		HandlerFunc(s.handleControl)
+
+	m.PathPrefix("/").
+		Methods("GET").
+		Handler(static)
+
+	s.handler = m
+
+	return s
+}
+
+func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.handler.ServeHTTP(w, r)
+}
+
+// Connected records that the broker has (re)connected, and subscribes to everything under home/.
+// The broker sends its retained messages again, which replace the state once they have all arrived.
+func (s *server) Connected() error {
+	s.metrics.BrokerConnected()
+
+	s.state.Connected()
+	s.status.Connected()
+	return s.broker.Subscribe("home/#", func(_ catbus.Client, m catbus.Message) {
+		s.metrics.MessageReceived(m.Topic)
+		retained := m.Retained == catbus.Retain
+		s.status.Message(retained)
+
+		s.state.Set(m.Topic, m.Payload, retained)
+	})
+}
+
+// Disconnected records that the broker connection was lost.
+func (s *server) Disconnected(err error) {
+	s.metrics.BrokerDisconnected()
+	s.status.Disconnected(err)
+}
+
+// Wait waits for every publish to finish, once no more requests are being served.
+func (s *server) Wait() {
+	s.coalesced.Wait()
+	s.publishes.Wait()
+}
+
+func (s *server) publish(topic, value string) error {
+	s.publishes.Add(1)
+	defer s.publishes.Done()
+
+	err := s.broker.Publish(topic, catbus.Retain, value)
+	s.metrics.Published(err)
+	if err != nil {
+		log.Printf("could not publish %q to %q: %v", value, topic, err)
+	}
+	return err
+}
+
+func (s *server) handleNotFound(w http.ResponseWriter, r *http.Request) {
+	msg := fmt.Sprintf("not found: %v %v %v", r.Method, r.URL, r.Form)
+	if r.URL.Path != "/favicon.ico" {
+		log.Print(msg)
+	}
+	http.Error(w, msg, http.StatusNotFound)
+}
+
+func (s *server) handleMethodNotAllowed(w http.ResponseWriter, r *http.Request) {
+	http.Error(w, fmt.Sprintf("method not allowed: %v %v", r.Method, r.URL), http.StatusMethodNotAllowed)
+}
+
+// Return the tree of zones/devices/controls under home/{path} as JSON, with the version of the state it was made from as "_version".
+// With since, only controls that have changed after that version are returned, and "_removed" lists topics that have gone.
+// With wait as well, the request waits up to that long for something to change.
+// For example,
+//
+//	GET /home/ => the entire home.
+//	GET /home/?q=lamp => zones, devices, and controls whose names contain "lamp".
+//	GET /home/?since=kf3x9a-42&wait=30s => whatever changes after version kf3x9a-42, as soon as it does.
+//	GET /home/bedroom => everything under home/bedroom.
+//
+// TODO: maybe actually do the prefix thing?
+func (s *server) handleHome(w http.ResponseWriter, r *http.Request) {
+	if !s.status.IsSynced() {
+		w.Header().Set("Retry-After", retryAfter)
+		writeJSON(w, http.StatusServiceUnavailable, map[string]interface{}{
+			"error": "waiting for state from the broker",
+		})
+		return
+	}
+
+	// A since from another epoch, such as from before a restart, can't be compared, so the client needs everything.
+	snapshot := s.state.Snapshot()
+	query := r.FormValue("q")
+	since, incremental, wait, err := parseLongPoll(r, snapshot.Epoch)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if incremental && wait > 0 {
+		timeout := time.NewTimer(wait)
+		defer timeout.Stop()
+	waiting:
+		for snapshot.Version == since {
+			select {
+			case <-snapshot.Changed():
+				snapshot = s.state.Snapshot()
+			case <-timeout.C:
+				break waiting
+			case <-r.Context().Done():
+				// The client has gone, or we're shutting down, so whoever is still listening should come back later.
+				w.Header().Set("Retry-After", retryAfter)
+				writeJSON(w, http.StatusServiceUnavailable, map[string]interface{}{
+					"error": "shutting down",
+				})
+				return
+			}
+		}
+	}
+
+	h := snapshot.Home().Filter(query)
+	include := func(topic string) bool { return true }
+	rsp := map[string]interface{}{
+		"_version": versionOf(snapshot),
+	}
+	// A client too far behind to know what has changed gets everything.
+	var changedTopics map[string]bool
+	var removed []string
+	if incremental {
+		changedTopics, removed, incremental = snapshot.ChangedSince(since)
+	}
+	if incremental {
+		include = func(topic string) bool { return changedTopics[topic] }
+		rsp["_removed"] = removed
+	} else if notModified(w, r, etagOf(snapshot, query, deviceStatuses(h))) {
+		return
+	}
+
+	for _, zone := range h.Zones() {
+		rspZone := map[string]interface{}{}
+		for _, device := range zone.Devices() {
+			rspDevice := map[string]interface{}{}
+			for _, control := range device.Controls() {
+				if !include(control.Topic()) {
+					continue
+				}
+				rspControl := map[string]interface{}{
+					"topic": control.Topic(),
+				}
+				switch control := control.(type) {
+				case *home.Enum:
+					rspControl["value"] = control.Value
+					rspControl["values"] = control.Values
+				case *home.Range:
+					rspControl["value"] = control.Value
+					rspControl["min"] = control.Min
+					rspControl["max"] = control.Max
+				case *home.Toggle:
+					rspControl["value"] = control.Value
+				default:
+					panic("unknown control type")
+				}
+				rspDevice[control.Name()] = rspControl
+			}
+			statusTopic := "home/" + zone.Name() + "/" + device.Name() + "/status"
+			if len(rspDevice) == 0 && !include(statusTopic) {
+				continue
+			}
+			rspDevice["_status"] = device.Status()
+			rspZone[device.Name()] = rspDevice
+		}
+		if len(rspZone) > 0 || !incremental {
+			rsp[zone.Name()] = rspZone
+		}
+	}
+	rspGroups := map[string]interface{}{}
+	for _, group := range h.Groups() {
+		var members []string
+		changed := false
+		for _, member := range group.Members() {
+			members = append(members, member.Topic())
+			changed = changed || include(member.Topic())
+		}
+		if !changed && incremental {
+			continue
+		}
+		rspGroups[group.Name()] = map[string]interface{}{
+			"power": map[string]interface{}{
+				"topic":   group.Topic(),
+				"value":   group.Value(),
+				"state":   group.State(),
+				"members": members,
+			},
+		}
+	}
+	if len(rspGroups) > 0 {
+		rsp[home.GroupsZone] = rspGroups
+	}
+
+	writeJSON(w, http.StatusOK, rsp)
+}
+
+// Liveness: if we can answer, the process is alive.
+func (s *server) handleHealthz(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"status": "ok",
+	})
+}
+
+// Readiness: the broker is connected and its retained state has arrived.
+func (s *server) handleReadyz(w http.ResponseWriter, r *http.Request) {
+	topics := s.state.Snapshot().Topics
+
+	details := s.status.Details()
+	code := http.StatusOK
+	if !details.Synced {
+		code = http.StatusServiceUnavailable
+	}
+	writeJSON(w, code, map[string]interface{}{
+		"ready":  details.Synced,
+		"broker": details,
+		"topics": topics,
+	})
+}
+
+func (s *server) handleMetrics(w http.ResponseWriter, r *http.Request) {
+	snapshot := s.state.Snapshot()
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	s.metrics.WriteTo(w, snapshot.Topics, snapshot.Raw())
+}
+
+func (s *server) handleIndex(w http.ResponseWriter, r *http.Request) {
+	user := userOf(w, r)
+
+	snapshot := s.state.Snapshot()
+	query := r.FormValue("q")
+	h := snapshot.Home().Filter(query)
+
+	page := indexPage{
+		Home:       h,
+		Connecting: !s.status.IsSynced(),
+		Query:      query,
+		IsFavorite: map[string]bool{},
+		Theme:      themeOf(r),
+		Themes:     themes,
+	}
+	topics := s.favorites.List(user)
+	for _, topic := range topics {
+		page.IsFavorite[topic] = true
+	}
+	page.Favorites = favoritesOf(h, topics)
+	if notModified(w, r, etagOf(snapshot, page.Connecting, query, page.Theme, topics, deviceStatuses(h))) {
+		return
+	}
+	if err := s.templates.indexTmpl.Execute(w, page); err != nil {
+		log.Printf("could not template: %v", err)
+	}
+}
+
+// Choose the current user's theme.
+func (s *server) handleTheme(w http.ResponseWriter, r *http.Request) {
+	name := r.FormValue("value")
+	if !isTheme(name) {
+		http.Error(w, fmt.Sprintf("unknown theme %q", name), http.StatusBadRequest)
+		return
+	}
+	setTheme(w, name)
+	if isFormPost(r) {
+		redirectBack(w, r)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"theme": name,
+	})
+}
+
+// The current user's favorite topics.
+func (s *server) handleFavorites(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.favorites.List(userOf(w, r)))
+}
+
+// Add (PUT) or remove (DELETE) a topic from the current user's favorites.
+func (s *server) handleFavorite(w http.ResponseWriter, r *http.Request) {
+	topic := mux.Vars(r)["topic"]
+	if _, _, _, ok := home.ParseTopic(topic); !ok {
+		http.Error(w, fmt.Sprintf("invalid topic %q", topic), http.StatusBadRequest)
+		return
+	}
+
+	user := userOf(w, r)
+	var err error
+	if r.Method == "PUT" {
+		err = s.favorites.Add(user, topic)
+	} else {
+		err = s.favorites.Remove(user, topic)
+	}
+	if err != nil {
+		log.Printf("could not update favorites: %v", err)
+		http.Error(w, "could not update favorites", http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, http.StatusOK, s.favorites.List(user))
+}
+
+// Write a value to a control of every device in a zone, or in every zone for _all.
+// Hidden zones and devices, and devices that are offline or stale, are left alone.
+// Form submissions from the page are redirected back to it if every control was set, and are told which weren't otherwise.
+// For example,
+//
+//	POST /home/_all/_all/power value=off => turn everything off.
+//	POST /home/bedroom/_all/brightness_percent value=50 => dim the bedroom.
+func (s *server) handleBulk(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	value := r.FormValue("value")
+	if value == "" {
+		http.Error(w, "must set value", http.StatusBadRequest)
+		return
+	}
+
+	h := s.state.Snapshot().Home()
+	zones := h.Zones()
+	if vars["zone"] != home.All {
+		zone, ok := h.Zone(vars["zone"])
+		if !ok {
+			http.Error(w, fmt.Sprintf("unknown zone %q", vars["zone"]), http.StatusNotFound)
+			return
+		}
+		zones = []home.Zone{zone}
+	}
+
+	resultsByTopic := map[string]string{}
+	var topics []string
+	for _, zone := range zones {
+		for _, device := range zone.Devices() {
+			control, ok := device.Control(vars["control"])
+			if !ok {
+				continue
+			}
+			topic := control.Topic()
+			if status := device.Status(); status != "online" {
+				resultsByTopic[topic] = fmt.Sprintf("skipped: device is %v", status)
+				continue
+			}
+			if err := home.ValidateValue(control, value); err != nil {
+				resultsByTopic[topic] = fmt.Sprintf("skipped: %v", err)
+				continue
+			}
+			topics = append(topics, topic)
+		}
+	}
+
+	// Through the coalescer, so a value still waiting to be published, such as from a slider, can't overwrite this one.
+	results := map[string]<-chan error{}
+	for _, topic := range topics {
+		results[topic] = s.coalesced.Set(topic, value)
+	}
+	// Each result arrives even if we're shutting down, as pending publishes are drained, so the client always hears how it went.
+	failed := false
+	for topic, result := range results {
+		if err := <-result; err != nil {
+			resultsByTopic[topic] = fmt.Sprintf("failed: %v", err)
+			failed = true
+		} else {
+			resultsByTopic[topic] = "ok"
+		}
+	}
+
+	code := http.StatusOK
+	if failed {
+		code = http.StatusBadGateway
+	}
+	if isFormPost(r) {
+		// Without scripts, the page can't show what wasn't set, so that is shown instead of going back to it.
+		var problems []string
+		for topic, result := range resultsByTopic {
+			if result != "ok" {
+				problems = append(problems, fmt.Sprintf("%v: %v", topic, result))
+			}
+		}
+		if len(problems) == 0 {
+			redirectBack(w, r)
+			return
+		}
+		sort.Strings(problems)
+		http.Error(w, fmt.Sprintf("set %d of %d:\n%v", len(resultsByTopic)-len(problems), len(resultsByTopic), strings.Join(problems, "\n")), code)
+		return
+	}
+	writeJSON(w, code, map[string]interface{}{
+		"results": resultsByTopic,
+	})
+}
+
+// Write a value to a control, once it has been published to the broker.
+// Form submissions from the page are redirected back to it.
+// Everything else gets the value accepted, or an error, as JSON; the control's new value arrives when the broker echoes it back.
+func (s *server) handleControl(w http.ResponseWriter, r *http.Request) {
+	fail := func(code int, format string, args ...interface{}) {
+		msg := fmt.Sprintf(format, args...)
+		if isFormPost(r) {
+			http.Error(w, msg, code)
+			return
+		}
+		writeJSON(w, code, map[string]interface{}{
+			"error": msg,
+		})
+	}
+
+	topic := r.URL.Path[1:]
+	value := r.FormValue("value")
+	if value == "" {
+		fail(http.StatusBadRequest, "must set value")
+		return
+	}
+
+	h := s.state.Snapshot().Home()
+
+	// Writing to a group writes to each of its members, leaving offline or stale devices alone as bulk writes do.
+	var control home.Control
+	topics := []string{topic}
+	var resultsByTopic map[string]string
+	if vars := mux.Vars(r); vars["zone"] == home.GroupsZone {
+		group, ok := h.Group(vars["device"])
+		if !ok || vars["control"] != "power" {
+			fail(http.StatusNotFound, "unknown group %q", topic)
+			return
+		}
+		control = group
+		topics = nil
+		resultsByTopic = map[string]string{}
+		for _, member := range group.Members() {
+			f, ok := lookup(h, member.Topic())
+			if !ok {
+				continue
+			}
+			if status := f.Device.Status(); status != "online" {
+				resultsByTopic[member.Topic()] = fmt.Sprintf("skipped: device is %v", status)
+				continue
+			}
+			topics = append(topics, member.Topic())
+		}
+	} else {
+		var ok bool
+		control, ok = controlOf(h, topic)
+		if !ok {
+			fail(http.StatusNotFound, "unknown control %q", topic)
+			return
+		}
+	}
+	if err := home.ValidateValue(control, value); err != nil {
+		fail(http.StatusBadRequest, "invalid value: %v", err)
+		return
+	}
+
+	results := map[string]<-chan error{}
+	for _, topic := range topics {
+		results[topic] = s.coalesced.Set(topic, value)
+	}
+	// As in handleBulk, wait for each result even if we're shutting down.
+	for topic, result := range results {
+		if err := <-result; err != nil {
+			fail(http.StatusBadGateway, "could not publish: %v", err)
+			return
+		}
+		if resultsByTopic != nil {
+			resultsByTopic[topic] = "ok"
+		}
+	}
+
+	if isFormPost(r) {
+		redirectBack(w, r)
+		return
+	}
+	rsp := map[string]interface{}{
+		"topic": topic,
+		"value": value,
+	}
+	if resultsByTopic != nil {
+		rsp["results"] = resultsByTopic
+	}
+	writeJSON(w, http.StatusOK, rsp)
+}
+
+// isFormPost returns whether r is a browser submitting a form, rather than a script or API caller.
+func isFormPost(r *http.Request) bool {
+	return strings.Contains(r.Header.Get("Accept"), "text/html")
+}
+
+// redirectBack redirects a form submission to the page it came from, so reloading doesn't resubmit it.
+func redirectBack(w http.ResponseWriter, r *http.Request) {
+	target := "/"
+	if referer, err := url.Parse(r.Referer()); err == nil && referer.Host == r.Host && referer.Path != "" {
+		target = referer.RequestURI()
+	}
+	http.Redirect(w, r, target, http.StatusSeeOther)
+}
+
+// versionOf returns the version of snapshot as clients see it, as "_version" and in ETags.
+// It includes the Store's epoch, so versions from before a restart never match.
+func versionOf(snapshot *store.Snapshot) string {
+	return fmt.Sprintf("%v-%v", snapshot.Epoch, snapshot.Version)
+}
+
+// etagOf returns a weak ETag for a response made from snapshot, and from anything else in parts.
+func etagOf(snapshot *store.Snapshot, parts ...interface{}) string {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%#v", parts)))
+	return fmt.Sprintf(`W/"%v-%x"`, versionOf(snapshot), hash[:8])
+}
+
+// notModified sets the ETag of a response, and responds with Not Modified if the client already has it.
+func notModified(w http.ResponseWriter, r *http.Request, etag string) bool {
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "no-cache")
+	for _, match := range strings.Split(r.Header.Get("If-None-Match"), ",") {
+		if strings.TrimSpace(match) == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return true
+		}
+	}
+	return false
+}
+
+// maxWait is the longest a request for changes can wait for them.
+const maxWait = time.Minute
+
+// parseLongPoll parses the since and wait parameters of a request for changes.
+// Since is only current if it is a version from epoch; one from before a restart is not, and nor is a bare number from before epochs.
+func parseLongPoll(r *http.Request, epoch string) (since uint64, current bool, wait time.Duration, err error) {
+	if v := r.FormValue("since"); v != "" {
+		sinceEpoch, version := "", v
+		if i := strings.LastIndex(v, "-"); i >= 0 {
+			sinceEpoch, version = v[:i], v[i+1:]
+		}
+		if since, err = strconv.ParseUint(version, 10, 64); err != nil {
+			return 0, false, 0, fmt.Errorf("since must be a version, got %q", v)
+		}
+		current = sinceEpoch == epoch
+	}
+	if v := r.FormValue("wait"); v != "" {
+		if wait, err = time.ParseDuration(v); err != nil || wait < 0 {
+			return 0, false, 0, fmt.Errorf("wait must be a positive duration such as \"30s\", got %q", v)
+		}
+	}
+	if wait > maxWait {
+		wait = maxWait
+	}
+	return since, current, wait, nil
+}
+
+// deviceStatuses lists the devices in h that aren't online, because a device going stale doesn't change the version.
+func deviceStatuses(h home.Home) []string {
+	var statuses []string
+	for _, zone := range h.Zones() {
+		for _, device := range zone.Devices() {
+			if status := device.Status(); status != "online" {
+				statuses = append(statuses, zone.Name()+"/"+device.Name()+"="+status)
+			}
+		}
+	}
+	return statuses
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	bytes, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	w.Write(bytes)
+}
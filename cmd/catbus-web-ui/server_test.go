@@ -0,0 +1,473 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"go.eth.moe/catbus"
+	"go.eth.moe/catbus-web-ui/favorites"
+	"go.eth.moe/catbus-web-ui/home"
+	"go.eth.moe/catbus-web-ui/store"
+)
+
+// fakeBroker sends its retained payloads to each subscriber, and records publishes instead of sending them.
+type fakeBroker struct {
+	retained map[string]string
+	err      error
+
+	// If unblock is set, publishes wait for it to be closed.
+	unblock chan struct{}
+
+	mu        sync.Mutex
+	published map[string]string
+}
+
+func (b *fakeBroker) Publish(topic string, retention catbus.Retention, payload string) error {
+	if b.unblock != nil {
+		<-b.unblock
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.err != nil {
+		return b.err
+	}
+	if b.published == nil {
+		b.published = map[string]string{}
+	}
+	b.published[topic] = payload
+	return nil
+}
+
+func (b *fakeBroker) Subscribe(topic string, f catbus.MessageHandler) error {
+	for topic, payload := range b.retained {
+		f(nil, catbus.Message{Topic: topic, Payload: payload, Retained: catbus.Retain})
+	}
+	return nil
+}
+
+func (b *fakeBroker) Published() map[string]string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	published := map[string]string{}
+	for topic, payload := range b.published {
+		published[topic] = payload
+	}
+	return published
+}
+
+var retained = map[string]string{
+	"home/bedroom/lamp/power":              "on",
+	"home/bedroom/lamp/brightness_percent": "40",
+	"home/kitchen/radio/power":             "off",
+	"home/kitchen/radio/input_enum":        "fm",
+	"home/kitchen/radio/input_enum/values": "aux\nfm",
+	"home/kitchen/kettle/power":            "off",
+	"home/kitchen/kettle/status":           "offline",
+	"home/kitchen/radio/groups":            "kitchen\nup/stairs",
+	"home/kitchen/kettle/groups":           "kitchen",
+}
+
+// newTestServer returns a server whose broker has connected and sent retained, once it has synced.
+func newTestServer(t *testing.T, broker *fakeBroker) *server {
+	t.Helper()
+
+	favorites, err := favorites.Open("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	state := store.New(home.Layout{}, nil, 0)
+	static := newAssetServer(assets(""))
+	s := newServer(broker, state, newBrokerStatus(state.Synced), favorites, newMetrics(), static, newTemplates(static))
+	if err := s.Connected(); err != nil {
+		t.Fatal(err)
+	}
+	waitForSync(t, s)
+	return s
+}
+
+func waitForSync(t *testing.T, s *server) {
+	t.Helper()
+
+	deadline := time.Now().Add(10 * syncQuietPeriod)
+	for !s.status.IsSynced() {
+		if time.Now().After(deadline) {
+			t.Fatal("server never synced")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func post(s http.Handler, path string, values url.Values, accept string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest("POST", path, strings.NewReader(values.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", accept)
+	rsp := httptest.NewRecorder()
+	s.ServeHTTP(rsp, req)
+	return rsp
+}
+
+func TestHomeJSON(t *testing.T) {
+	s := newTestServer(t, &fakeBroker{retained: retained})
+
+	req := httptest.NewRequest("GET", "/home/", nil)
+	req.Header.Set("Accept", "application/json")
+	rsp := httptest.NewRecorder()
+	s.ServeHTTP(rsp, req)
+
+	if rsp.Code != http.StatusOK {
+		t.Fatalf("got %v, want %v: %s", rsp.Code, http.StatusOK, rsp.Body)
+	}
+	var got map[string]interface{}
+	if err := json.Unmarshal(rsp.Body.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	if got["_version"] == nil {
+		t.Errorf("got no _version in %v", got)
+	}
+	lamp := got["bedroom"].(map[string]interface{})["lamp"].(map[string]interface{})
+	want := map[string]interface{}{
+		"_status": "online",
+		"power": map[string]interface{}{
+			"topic": "home/bedroom/lamp/power",
+			"value": true,
+		},
+		"brightness": map[string]interface{}{
+			"topic": "home/bedroom/lamp/brightness_percent",
+			"value": 40.0,
+			"min":   0.0,
+			"max":   100.0,
+		},
+	}
+	if !reflect.DeepEqual(lamp, want) {
+		t.Errorf("got lamp %v, want %v", lamp, want)
+	}
+	kettle := got["kitchen"].(map[string]interface{})["kettle"].(map[string]interface{})
+	if kettle["_status"] != "offline" {
+		t.Errorf("got kettle status %v, want offline", kettle["_status"])
+	}
+	// A group name with a '/' has no topic to write to, so the radio only joins the kitchen.
+	groups, _ := got[home.GroupsZone].(map[string]interface{})
+	if _, ok := groups["kitchen"]; !ok || len(groups) != 1 {
+		t.Errorf("got groups %v, want only kitchen", groups)
+	}
+}
+
+func TestHomeJSONBeforeSync(t *testing.T) {
+	favorites, err := favorites.Open("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	state := store.New(home.Layout{}, nil, 0)
+	static := newAssetServer(assets(""))
+	s := newServer(&fakeBroker{}, state, newBrokerStatus(state.Synced), favorites, newMetrics(), static, newTemplates(static))
+
+	req := httptest.NewRequest("GET", "/home/", nil)
+	req.Header.Set("Accept", "application/json")
+	rsp := httptest.NewRecorder()
+	s.ServeHTTP(rsp, req)
+
+	if rsp.Code != http.StatusServiceUnavailable {
+		t.Errorf("got %v, want %v", rsp.Code, http.StatusServiceUnavailable)
+	}
+	if got := rsp.Header().Get("Retry-After"); got != retryAfter {
+		t.Errorf("got Retry-After %q, want %q", got, retryAfter)
+	}
+}
+
+func TestLongPollCancelled(t *testing.T) {
+	s := newTestServer(t, &fakeBroker{retained: retained})
+
+	req := httptest.NewRequest("GET", "/home/", nil)
+	req.Header.Set("Accept", "application/json")
+	rsp := httptest.NewRecorder()
+	s.ServeHTTP(rsp, req)
+	var tree map[string]interface{}
+	if err := json.Unmarshal(rsp.Body.Bytes(), &tree); err != nil {
+		t.Fatal(err)
+	}
+
+	// Nothing changes, and the request is cancelled while waiting, as it is when shutting down.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req = httptest.NewRequest("GET", fmt.Sprintf("/home/?since=%v&wait=10s", tree["_version"]), nil).WithContext(ctx)
+	req.Header.Set("Accept", "application/json")
+	rsp = httptest.NewRecorder()
+	s.ServeHTTP(rsp, req)
+
+	if rsp.Code != http.StatusServiceUnavailable {
+		t.Errorf("got %v, want %v", rsp.Code, http.StatusServiceUnavailable)
+	}
+	if got := rsp.Header().Get("Retry-After"); got != retryAfter {
+		t.Errorf("got Retry-After %q, want %q", got, retryAfter)
+	}
+	if err := json.Unmarshal(rsp.Body.Bytes(), &tree); err != nil {
+		t.Errorf("could not parse response: %v", err)
+	}
+}
+
+func TestIndex(t *testing.T) {
+	s := newTestServer(t, &fakeBroker{retained: retained})
+
+	req := httptest.NewRequest("GET", "/", nil)
+	rsp := httptest.NewRecorder()
+	s.ServeHTTP(rsp, req)
+
+	if rsp.Code != http.StatusOK {
+		t.Fatalf("got %v, want %v", rsp.Code, http.StatusOK)
+	}
+	body := rsp.Body.String()
+	for _, want := range []string{"bedroom", "lamp", "radio", "action='/home/bedroom/lamp/power'"} {
+		if !strings.Contains(body, want) {
+			t.Errorf("page does not contain %q", want)
+		}
+	}
+
+	// Unchanged, the page is not sent again.
+	req = httptest.NewRequest("GET", "/", nil)
+	req.Header.Set("If-None-Match", rsp.Header().Get("ETag"))
+	req.AddCookie(&http.Cookie{Name: userCookie, Value: "test"})
+	rsp = httptest.NewRecorder()
+	s.ServeHTTP(rsp, req)
+	if rsp.Code != http.StatusNotModified {
+		t.Errorf("got %v for an unchanged page, want %v", rsp.Code, http.StatusNotModified)
+	}
+}
+
+func TestETagAfterRestart(t *testing.T) {
+	etagOfIndex := func(s *server) string {
+		req := httptest.NewRequest("GET", "/", nil)
+		req.AddCookie(&http.Cookie{Name: userCookie, Value: "test"})
+		rsp := httptest.NewRecorder()
+		s.ServeHTTP(rsp, req)
+		return rsp.Header().Get("ETag")
+	}
+
+	before := etagOfIndex(newTestServer(t, &fakeBroker{retained: retained}))
+	after := etagOfIndex(newTestServer(t, &fakeBroker{retained: retained}))
+	if before == after {
+		t.Errorf("got the same ETag %v after restarting, want a different one", before)
+	}
+}
+
+func TestControl(t *testing.T) {
+	tests := []struct {
+		name          string
+		path          string
+		value         string
+		accept        string
+		brokerErr     error
+		wantCode      int
+		wantBody      string
+		wantPublished map[string]string
+	}{
+		{
+			name:          "json",
+			path:          "/home/bedroom/lamp/power",
+			value:         "off",
+			accept:        "application/json",
+			wantCode:      http.StatusOK,
+			wantPublished: map[string]string{"home/bedroom/lamp/power": "off"},
+		},
+		{
+			name:          "form",
+			path:          "/home/kitchen/radio/input_enum",
+			value:         "aux",
+			accept:        "text/html",
+			wantCode:      http.StatusSeeOther,
+			wantPublished: map[string]string{"home/kitchen/radio/input_enum": "aux"},
+		},
+		{
+			name:          "invalid value",
+			path:          "/home/bedroom/lamp/brightness_percent",
+			value:         "101",
+			accept:        "application/json",
+			wantCode:      http.StatusBadRequest,
+			wantPublished: map[string]string{},
+		},
+		{
+			name:          "unknown control",
+			path:          "/home/bedroom/lamp/kelvin",
+			value:         "3000",
+			accept:        "application/json",
+			wantCode:      http.StatusNotFound,
+			wantPublished: map[string]string{},
+		},
+		{
+			name:          "broker error",
+			path:          "/home/bedroom/lamp/power",
+			value:         "off",
+			accept:        "application/json",
+			brokerErr:     errors.New("broker is down"),
+			wantCode:      http.StatusBadGateway,
+			wantPublished: map[string]string{},
+		},
+		{
+			name:     "all devices",
+			path:     "/home/_all/_all/power",
+			value:    "on",
+			accept:   "application/json",
+			wantCode: http.StatusOK,
+			// The kettle is offline, so it is left alone.
+			wantPublished: map[string]string{
+				"home/bedroom/lamp/power":  "on",
+				"home/kitchen/radio/power": "on",
+			},
+		},
+		{
+			name:     "all devices form",
+			path:     "/home/_all/_all/power",
+			value:    "on",
+			accept:   "text/html",
+			wantCode: http.StatusOK,
+			// Without scripts, the page can't show what was skipped, so it isn't redirected back to.
+			wantBody: "set 2 of 3:\nhome/kitchen/kettle/power: skipped: device is offline",
+			wantPublished: map[string]string{
+				"home/bedroom/lamp/power":  "on",
+				"home/kitchen/radio/power": "on",
+			},
+		},
+		{
+			name:     "group",
+			path:     "/home/_groups/kitchen/power",
+			value:    "on",
+			accept:   "application/json",
+			wantCode: http.StatusOK,
+			// As with all devices, the offline kettle is left alone.
+			wantPublished: map[string]string{"home/kitchen/radio/power": "on"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			broker := &fakeBroker{retained: retained, err: tt.brokerErr}
+			s := newTestServer(t, broker)
+
+			rsp := post(s, tt.path, url.Values{"value": {tt.value}}, tt.accept)
+			if rsp.Code != tt.wantCode {
+				t.Errorf("got %v, want %v: %s", rsp.Code, tt.wantCode, rsp.Body)
+			}
+			if !strings.Contains(rsp.Body.String(), tt.wantBody) {
+				t.Errorf("got body %q, want it to contain %q", rsp.Body, tt.wantBody)
+			}
+			if got := broker.Published(); !reflect.DeepEqual(got, tt.wantPublished) {
+				t.Errorf("got published %v, want %v", got, tt.wantPublished)
+			}
+		})
+	}
+}
+
+func TestBulkAfterQueuedWrite(t *testing.T) {
+	broker := &fakeBroker{retained: retained, unblock: make(chan struct{})}
+	s := newTestServer(t, broker)
+	topic := "home/bedroom/lamp/brightness_percent"
+
+	var wg sync.WaitGroup
+	postAsync := func(path, value string) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			post(s, path, url.Values{"value": {value}}, "application/json")
+		}()
+	}
+
+	// The first value is being published, and the next waits behind it, as when dragging a slider.
+	postAsync("/"+topic, "10")
+	waitForQueue(t, s.coalesced, topic, "")
+	postAsync("/"+topic, "20")
+	waitForQueue(t, s.coalesced, topic, "20")
+
+	// Then the whole bedroom is set, which must win.
+	postAsync("/home/bedroom/_all/brightness_percent", "50")
+	waitForQueue(t, s.coalesced, topic, "50")
+	close(broker.unblock)
+	wg.Wait()
+	s.Wait()
+
+	if got := broker.Published()[topic]; got != "50" {
+		t.Errorf("got %q published last, want %q", got, "50")
+	}
+}
+
+// waitForQueue waits until c is publishing to topic, with value waiting to be published next, or nothing if value is empty.
+func waitForQueue(t *testing.T, c *coalescer, topic, value string) {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		c.mu.Lock()
+		queued := c.running[topic] && c.pendingByTopic[topic].value == value
+		c.mu.Unlock()
+		if queued {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("%q never queued for %v", value, topic)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestMetricsCountsUnmatchedRequests(t *testing.T) {
+	s := newTestServer(t, &fakeBroker{retained: retained})
+
+	if rsp := post(s, "/nowhere", url.Values{"value": {"on"}}, "application/json"); rsp.Code != http.StatusMethodNotAllowed {
+		t.Errorf("got %v, want %v", rsp.Code, http.StatusMethodNotAllowed)
+	}
+
+	req := httptest.NewRequest("GET", "/metrics", nil)
+	rsp := httptest.NewRecorder()
+	s.ServeHTTP(rsp, req)
+	body := rsp.Body.String()
+	for _, want := range []string{
+		`catbus_web_ui_http_requests_total{route="unknown",method="POST",code="405"} 1`,
+		`catbus_web_ui_control_value{zone="bedroom",device="lamp",control="power"} 1`,
+		`catbus_web_ui_control_value{zone="bedroom",device="lamp",control="brightness_percent"} 40`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("metrics do not contain %q", want)
+		}
+	}
+}
+
+func TestControlWhileShuttingDown(t *testing.T) {
+	for _, path := range []string{"/home/bedroom/lamp/power", "/home/_all/_all/power"} {
+		broker := &fakeBroker{retained: retained}
+		s := newTestServer(t, broker)
+
+		// Shutting down cancels every request's context, but writes already made are still published.
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		req := httptest.NewRequest("POST", path, strings.NewReader(url.Values{"value": {"off"}}.Encode())).WithContext(ctx)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.Header.Set("Accept", "application/json")
+		rsp := httptest.NewRecorder()
+		s.ServeHTTP(rsp, req)
+
+		if rsp.Code != http.StatusOK {
+			t.Errorf("got %v for %v, want %v", rsp.Code, path, http.StatusOK)
+		}
+		var body map[string]interface{}
+		if err := json.Unmarshal(rsp.Body.Bytes(), &body); err != nil {
+			t.Errorf("could not parse response for %v: %v", path, err)
+		}
+		if got := broker.Published()["home/bedroom/lamp/power"]; got != "off" {
+			t.Errorf("got published %q for %v, want %q", got, path, "off")
+		}
+	}
+}
@@ -0,0 +1,5 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+// Scripts for this install, loaded after the built-in ones. Replace this file with one in the assetsDir.
@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+// The service worker caches the page shell and static assets so the app opens offline,
+// and keeps the last known state of the home for the offline view.
+
+'use strict';
+
+const cacheName = 'catbus-web-ui-v3';
+const shell = [
+	'/offline.html',
+	'/manifest.json',
+	'/turbolinks.js',
+	'/custom.css',
+	'/custom.js',
+	'/icon.svg',
+	'/ios-icon.png',
+];
+
+self.addEventListener( 'install', e => {
+	e.waitUntil( caches.open( cacheName ).then( cache => cache.addAll( shell ) ).then( () => self.skipWaiting() ) );
+} );
+
+self.addEventListener( 'activate', e => {
+	// Drop caches from older versions of the service worker.
+	e.waitUntil(
+		caches.keys()
+			.then( names => Promise.all( names.filter( name => name !== cacheName ).map( name => caches.delete( name ) ) ) )
+			.then( () => self.clients.claim() )
+	);
+} );
+
+self.addEventListener( 'fetch', e => {
+	const request = e.request;
+	const url = new URL( request.url );
+	if ( request.method !== 'GET' || url.origin !== self.location.origin ) {
+		return;
+	}
+
+	// Pages always come from the network, falling back to the offline view.
+	// Each page loaded also refreshes the state kept for the offline view.
+	if ( request.mode === 'navigate' ) {
+		e.respondWith( fetch( request ).catch( () => caches.match( '/offline.html' ) ) );
+		e.waitUntil( fetchState( new Request( '/home/', { headers: { Accept: 'application/json' } } ) ).catch( () => {} ) );
+		return;
+	}
+
+	// The state of the home comes from the network, but the last copy is kept for the offline view.
+	if ( url.pathname === '/home/' && !url.search ) {
+		e.respondWith( fetchState( request ).catch( () => caches.match( '/home/' ) ) );
+		return;
+	}
+
+	// Static assets come from the cache, and are refreshed in the background.
+	if ( shell.includes( url.pathname ) ) {
+		e.respondWith( caches.open( cacheName ).then( cache => cache.match( request ).then( cached => {
+			const fetched = fetch( request ).then( rsp => {
+				if ( rsp.ok ) {
+					cache.put( request, rsp.clone() );
+				}
+				return rsp;
+			} );
+			return cached || fetched;
+		} ) ) );
+	}
+} );
+
+// fetchState fetches the state of the home, and keeps a copy if it's complete.
+const fetchState = request =>
+	fetch( request ).then( rsp => {
+		if ( !rsp.ok ) {
+			return rsp;
+		}
+		const copy = rsp.clone();
+		return caches.open( cacheName ).then( cache => cache.put( '/home/', copy ) ).then( () => rsp );
+	} );
@@ -1,13 +0,0 @@
-// Code generated by statik. DO NOT EDIT.
-
-package statik
-
-import (
-	"github.com/rakyll/statik/fs"
-)
-
-
-func init() {
-	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00\xb4\x89|Q\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x08\x00	\x00icon.svgUT\x05\x00\x01E\x85\xc2_\\\x8fMN\xc30\x10F\xf79\xc5\xa7Y\x81\x94&c\x97\x8a6\x7f\x0b\xd6\xe1\x10\x85\x98\xc4`\xec\xc86q\xb8=*\n\x12\xed\xe6\xe9\xe9\x8df\xf15a\x19\xb1~\x1a\x1bZ\x9ab\x9c\xab\xb2L)\x15i_8?\x96\x92\x99\xcb\xb0\x8c\x84E\xf9\xa0\x9dmI\x14\x82\xb2E\xab\xf4\xe4\xd6\x96v\x92\x19\xbf\x10\x0f\x1b\xa8\xcb\x80f>\xc7	!~\x1b\xd5R\x88\xde}\xa8]\xd2C\x9c*\x9cx^kl\xcdh\xab^\xcfs\x05\xef\xbe\xecp\x95\xdf\x9d\xb67\xbd\x82\x1f_\xee\xa4\x149\xc4a\x9fC<\xf2}\x8d7mL\x05\xeb\xac\xaa)\x03\x86\x96\x9e\x05s.\x8f\x8c\xfe\"\x82\x99\xd1\x9f\xae\xec\xef\xb8\xd9\x819g\xf4\xff\x9e\xe4\x91	e\x975\x97\xf9]\xf6\x13\x00\x00\xff\xffPK\x07\x08SZ\xe7i\xc9\x00\x00\x00'\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xc3\x89|Q\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0c\x00	\x00ios-icon.pngUT\x05\x00\x01^\x85\xc2_\xec\xfcy<\x94\xfb\xff?\x8e?-IY\xdb(	G\x8b6\x94%\x13B\xa5\x14\x95\x84\xc8Z8\x111#\x13\xb2\xcd\xd5Q\xd1q\xe2\x94\xca\x11\x12\x8el19B\x961S\x92$k!d\x0c\xb2oc\xdff\xf9\xdd\xaek\xea\x9c\xf7KW\xbf\xdb\xf4\xe7\xf7\xf6in\xb7\xd3\x99\x9by^\xcf\xe5\xf1|<\xef\x8f\xfbcy^7O\x9e0\x10Y.\xb5\x1c\x00 r\xf4\x88\xfe)\x00\x96\xf8\xc2\xff\x89\xf3\x02\x00\xca\xe6X\x1b\x01\x00\xfc\xce\xfb\x8f\xef\x07 ;B\x88qn	\x00@\xce\xf1\xc8\xa9\xe3\x00\xf8m\x01\x00\xba\x06\xc0<\x00\x00\xea\x03\xe0\xf2.\x00\x06\xce\x02\xa0\xf9\x17\x00\x92\xb8\xd8B\x13m\x00\x80\x80\x83\x91\x81>\x1b\xf9,\xb3\x9c\xf9\x04\x00X\x86;r\xc6\x0b\x00p\x16\xf9\x8f\xeev\xa0\x06\x00\xb0\x14\x7f\xf4\xf8\xa1\xa5\x9f\x85\xa4W\x88\n\x1c\x96rc\x01\x00\x81\xa3\xfa\xfb\xcd|[\x86\x98n^1\xce\xbf\xb5\x0f\xf2\xd2Vhw\xea\x9dJ\xc48\x18uZ^\xacS\xfb%\xbc\xffWC\xa9\x0c\x0b\x95\xfe\xacK\xf5;\xdc\xa8\n\xb2\xcas\xc0\x9fXq\xe2^\\\xf7\xd0\xe9\xc7\xbe\x96\xc1\xeb\x96}\xb8\x8d\xdfZ!\xd1,\xbf\x99\x97\x7f\xd3Jm\xde\x8f\xcajE[\xfa}\x0b\x15o\xd6W\x85\x14gY\xa4gy4\xd50\x7f\x93\x1f\xe9\x8d\xe8\x0d/\x03????????\xff/| ]y\x19M\xf8\x0b&|z\xfa\xf7\xb2KW\x08\x04\xaf\x89\x86\x8c\xe0\xe0\xaa*\xc3n\x1a\xad!c8\xb3\xb4t83\xae\xcd\xca\xdd\xd9\xd9'\xfftkssk\x98\xba\xc3\x98e\x12\xf5ck\xab\xae\xef\x04\xf9\xf8\xab\xd6\x16+k\x871\xcb\x8d\x12XW\xc3-\xad-\x0f\x1e\xdc\xa9\x98\x9e\xee\xe9\xa9\xcer\xf27226v\xb602\xd2lkmmypbf\xf2Y\xee\xaf9if}\x04G\x00\x00\xcdw\xb7\x0b<\x05Sw\x9f\x99\xb20\x87l+\xe6Xynn[[n\xefz\xe5\x19o\xfb\xe2\xad\xf7\x07n\x05\x97\x96\x96F\xbc\xff34_MC#?WL\xd8Iux\xd8y\xe9\xc2\xbc\x96\xe6\x8d\x90\x9a\x87\xeeX\xd9\xb5o\xce\x1fd\xeb\xf2\x03\x00\xae\x9d\xab\x85\x17\x92\xf2\xa6\xba\xba\xa5\xe5\xf5\xeb\xc6\xc1Q2\xbb#dx\xe0}JFF\xaeUna\xd1\xfa\xc2\xc1\x14u\xf5\xcb\xf8g\xf9>\xde6\xcfw\xa9}h`V(\x90g\x0f\xa9\\\x99\xbeho\xdf\xa7\x04O\xc9q\x172\xa5UY6J\xab%$\xedI~\x93\xf4\xb1\xae\xe0\xe0K\xddS\x83\xb5\x87F\xb3\x9277\xb4\xb6\xb5\xbd\x9f\xa8\xdc\x11\xa5\x94|\xaa\xe0S\x9fK\x9f<\xfc\xd8A\xceJV\xa5>\xdf\xada\xfd|b`\xaa\xd4 \"\xfe\xc3\xe0\xc2\xc2\xd2\xa5	a\xe1v\xf2u--\xf5\xf5\xef\x03\xbb\xa3\xa2\x94\xce/<&vg\xddP\x82\x0dk\xf8\x9d\xfd|\x00\x80X\xf1\xe4\xfavf\x1b\xc9\xff\xdex\xdb\xf5\xe4d\xbf.\x1a\x9d\xbe\x0b_W\x1e\x89,{\xe6\xb1N\xcc\xf3\x80\xbd\x91s\x9dacc\xb5\x87\x00\x00\x94\x05\x15d8\xc1\x0c3\x19mK;\xea\xa7w\xbd\xa3\xed\xc4\x06ooo\x9f\x83\x92\xd7\x9b\xee\xba`\xb1\xee\xc3\xc7\x8f\x1f\xfb#\xfc\xf1\xfb\xc8H\x8b\xack\xc8`\xd4\xeb\xc8`\xfa\xc7r\x0b\xff\xf9'\xef\xf4\xce\xeaj\x89\xe5\x0b\x0b\xc9\xa7\x92W\x16\xf6\xcd\xba^8\x9a\\X\xa4\x1a\x9d\x9666\x96\x95u\xfb\xf6D\xefAI\x1e\x00\x80n\x14\xe7!\n\xd5.\xcd,\xed\xae\xe1\x84\x97\x90P\xc3@D\xcdC]1\xdb\xd5\xfb*>\x17x\xf11\x9b\x87r\xdd\n2\xdc\xdd\x03\x03\xfb\xf1\x1cqDp\xc4\xe18\xdc]i\x9d?>gq\xcf@,^\xfbf\xd9\xfa\xec\xc3\x1b\xf1&\xaf\xd4;\xb3}\x8bs\x06;\xcb\x12\x12r\x9c	\xcc\x98\xc1\xa6\x83K\x91\xb1\xaa\x1e\xc1\xff\x0b\x8f\xab\xaaj\xcd\x97\xd5\xd9\x195\xfa)h\xf2\xa9]\xbb.\x06#l\x8bW)s\xac\xaa:~\x93\xfaavV!*\xa7Y\x99\x1c\xfb\x97\xa0-\xbc\xef\x8cU\xc1\x02\xb0\x02K\xe4\xe5\xdd\x8f\x8a\xdf\xb7\xc3\xe3]\x95\xc4\xf2\x90\xdc\xccaWu|]\x7f\xfd\x87\x0fu\xb7\x8f=~\x1fO\xd0\xd9\xd3\xd8\xaa\xb5g\x8f\xca\xcaBA\x00\xc0	-\xe4)\xc1\xc7V\xb6\xb6\xb6\xf6\xca\xf7zz\x96\xfbLO\xef\xd9\xf3\xf6^\xed\xa1W\x0e\xd9\x96nn\x9am\xef#+\x8e\x19\xf7^\x8a\x8b+-\x0d\x0eN\x08\x83W\xa6\xc6\x91<hl,a\x9e?\x7f\xde\x7fa,\xf2\xde\xbdZE\xc6\xee\x8a\xcf\xb5\x05\xaa|\xcdC\xb9\x83\xb0(\x9a/n_\xc3\x19F\x08\x19f\xf6\xc8\xefess\xd7\xaf\x1b\xce\x8d\xbc\xacw\xd8x:\xa3i}B\xd8lAg\xb6\xbe\xbc\xcc\xe0\xc8\xba\x0d$\xdf42\xbb\xa7*-\x0d\xd9_\xdc\xf8:5x\x9b\x97\x9a)\xb5\x93\x8a\x9e=+\x88\xaei'[\x10GGe\xa5o(u\x06n\xeeT\xac\xaa\xaa\"\xa5\xdd\xfc\xd4]\x19\xfd\xee\xc3\x87Y_D\xea\x98ha)x\x8a\xa1\xeeX\xdd\xc0\xc2B\xab\x9d\xd5\xd5\xab\x97/]J\xb4\xc3\xba_W\xea<x\xc1c``\xe8\xc8cUS\xfb?\x08\x8d\xefU5\xfd:\x0ep\x1e\xdb\xcbyLph\xa4\xc4\xd7d\xa7\x84\xc6y\xe7\xe5\xd7\xdbH\xb9S\xb7n\xd5\x1e\n\xb8\xe8\x9e\xc9\xc7\x17},\x0f\xeb\xa7\x19e\xb4e\xe9\xd2\x85\x05D/\xf4\x9eqf\xe8\x7f\xc6\x9e=R47\x97\xd9\xd4\xae\xcc\xa4\xabID\x94\x056n\x91\x18\xec[+#\xa3\xad\x85\x99\x9dj\xb2o\x13n\x83\x95\xcf\xa4\xfbO\x982R\xad\xd2\xee\xd6\xb4\x17Y(\xb5\x17\x90\x9e\x7ft\xf90\x90k\xd5'\x1f\x9e\xe4\xbbs\x85\xf1&?\xbfr\x9d\x0d\xeb\xfa\x07\x8b<\x1d/\xa9\xe0W\xc0Zw\x13Q>\xc6\xce{o\x83\x97VV\xc6\xc4\xcc1\x98\x0b\xb9-\xd5\xd5#\x1e}\xf2\x89\x7fo\xab\xa3R[Zb\x1e\xfc\xb5v\xc3\xf1\x14\x05\x05\xa2\xcd2D\x1f\xca\xe38O\xe5\xa47\xdc\xbb\x97\x9b\xeb\xe0\xe9\x9dw\xf2c\xbb\xf2\xbd\xed\xf7j\x0f\xc5\xf2+*\xa6\x99\xf9\xf8lQUW\xd7\xa16\xa8\xab\\b\x05p\xa4\x10\xce\x91\x02\xe1\x9f|\x8b\xed\xe6\xdb\x93\xeb\x19\x9f^\x1e\xc8\x9d\xef\xca\xe9\xad9(\xe9ygsdo\xb5\xc8\xa4sNSVwV^\xee\xd4T\xf2{\x8e:<\xe7\xc0\x05ar\xbc;\xd3z\x8e\x91\xf16)\xc4\xf8\xe1\xab\xce[\x96I+\nCM\x93VD\x94u\xc5\xd9J*\x8b\x8a\xc4\xed[\x18l\xb7!\xcbh\xc2J\xf1R\x15~\x8af\xd6\xfa\xa98p\xa1\xa3\xf4\xd2\\\xf5\xb0\x81\xd8\xe0\x1438\xb8\xb4\xf6P\xac\xf7\x98\xa5\x97\x97i\xa2\x86\xba\x0e\xab\xa2\xfb\xe2t\xa5\xb2\xc2\x96\x03\x88\xcc\xd7\n\x88\xc0`\xa1im]YY\xf3p\x8dF\xcfy\xa1\xeb\xa5-\x8d\xde\xb9R\x85\x82AV\xa6\xa6\x06\x06*\xd1'\x08\xac\xb9\x8f\x96\xd8\xd1\x8f\x1f\xdf\x1cA\x166\xeb\x8b,\x0c\xb3&\xbd\xa1\xa1aj\xea\xd4\x05\x8f,\x9c3\x16\xeb\x98\x04K\x0b\x9b\x92\xab\x1e7\xd2_\xe1\xf48\xc5\xab\xa0\xba\xba\xa3\xe3\xc5\x0bD\x01\xe9\xe7\x90\xed\xc5\xbd\xcc\xcc\x0c\\\xa8z\xf7nz\xbaKa\xc7\x8e\x04k\x9a\xcb\xa6\xc8\x83\xbd\xd5\xc2N9MY\xbdYy\x1c,G\xc4\xe7\xc7\x99\x9b^Y'\x83\xf1\xf2@r\x80\xcf\xb9\x0b\xd9+2\x1a\x06o\xd5\x1e\xc2M~O\xcd\xefr\xa6\xf6\xcb\xdb\xee\"\x9f\xa8\xfb{4&\xe6\x16f\x06\xa6\x98LX\x0c\xf4\xb1M\xe8j\xeek\x8f\x0c\xa5\xffqz\x1aF\xf6\xb2\xce\xa1c	8\x1c}`\xaa\xf1t\x9f\xbc \xb1 -#gct\xf5\xb1\xc0\xdd\xac3\xc65}}\xd7\x858;e\xbb\x16^\x91\xf8\xee\xa2\"e\xbb\xd5ae\xb7\x9a\xa3\x0f\x9c\xf3\xc1\xba\x07:n\xc4\xaf\x90\xb6G\xd7rJ\xcd\xaf\xb0I\xd1szB$\xcc\xb41\x18\x8d\x83\xa3QA\xbeRa\xe5\x9d:\x0d/[\xfb\xbe\xd5r\xc2\x83$Y\x18\xc1rOm\x8b'\xfb\x10mJ\xbc\xfc=\xb3\xebR\x1b\xdcrk\x0f\x89\xbb\x8fY\xca?<29\x19\x1e\xa8\xa9R\xdf\xe4\xd3\xf5\xfa\xb3\x13g\x87. \x0b\x92\xd6B\xd3rL\xf4\x83\x95\xc3\xc3\x99\x99\xa4\xb4\x9b7\xeb\xea\xf0~\x97\xc6a\x80\x82\xb7\xc8\x98#<	\x14-\xc7\x15>{\xf6\xf7\xa9\xb66\x8e\xd4,\x9a\xde\xbc\x99\x9b@\xa4\x80\x19F\x1e\x12<\x8f%\x9a\xa5\x9b\x19&\xcf\x15\\\xbf\xee:U\xee\\\x13\x1f\xa2\xc4\xeb\x90m\xb93Z\xdb\xc7\xe3\x91n\x0c\xc9[\xd1'73\x93\xa8\x1c'\n\x1f)\xca\x05'D\x0e@\xd9\x9eZx\xaf\x86\x9c\xae\xed7\xfb\xf9\xe8Nj\xa8\xbaTB\x18\xc4>\xb4Q&\xac\\\xabH\xc9NZJ\xd3\x7f\xba\x89\\\x10\xb4\x0f/\x0e[\xe20\xe4 \xcaV\xf4\xf4\x0c\xb7\x8a\xc9\xde\xaf\xf4\xf1\xd0\xf5\xb3$\x8e\xcaJK\xdfP\xe2\x0d\xca\xf2}RP\xb0\xad.FJ\x96\xfd\xf9\xd2\x9e\x85\xee\xa8U\xab8\x93\x9cC&9\x0b\xa1\xe0\xf9\nz\xc9\x83\x07\x9a\x9aw*\xf0\xf3\x135\xe5\xaf\xfd\xfc\xcb_\x1f>\x8c\x9c\x0e\x9c\nGe_\xa1\xe9y\xc0\xd6\xcc\xcc~\xbc\xb1\xf1\x16\xd5}q\x95\x97\xbdE\xc5\xc4D\x11\x03L\xfb\x8c\x9c_\xda\x9b'\xff\xab\xe7P\x84b\xb4\xb4\xa6\xcfy\xb9\x18\x92\xf7>\x9f\xdcL\xc5\x98\xe3\xc9\xc8(\xe2\xee\xc8^\x15G9x\x06\x05\x9dK8UR\xf4\xf4\xd9\xc7\xd0\x86\x81\xa9\xc3}\xf2r\x7f\xfb\xee\xbc\x1dZ^\xe8\x7f\xd8@\xd9n`\x94|\xc5\xcbu;\x02c&\x88\x16Q\xacwD\x8dRm\xadZ\x10k\x9d\xd143#&zP\x92\xa7\xdcD\x9eZ\xff\xe9\xd3\xa7\x98\x07olKb+\x8e\x1d\xbf\x15\x11\x02OM\xce\x90#\x853y\xf9\xf1:\xb6\xb6\x8a\x12\xce\xce\xcb\xaf\x07\x07[\x10\xf3\xf3\x0eH\xf2\x98\xd4\x7f\xfa\xd0\xd0|\xfb\x98\xf3\xe3\x92\xb5\xec')\xce\xf8\xc9\x97\xd7\x11\xb5\x90\xf6A&\xc8\xc8\xf1\xf6\xb1/\xde*\x11\xb6>G\xf5\xfa\xcb\x11j\xcb\xfc\xeb\xc3}\xf2\xb4M.n\x1b\xd6\xbeq\xfa'\xf0\xfd\xfb\x02\x1b\x95\xbd\x98\x8f\xed\xb2\xb0\x95\x82\xba\xde\xc0\x96\x17\x8a\x7fZ\xc2j\xf3\x99\x98\xb0&\x92\xed\xe7;.\xec\x94	[`\x08\x06\xac\xdd\xb0\xa1\xb44\xd8y\xe9R2k>#&\xc1\x1a\xde\";\xe9#<\x00\xd0#\x0c\x93\x03XT\x12\x0c`ee\x87\x93N\x13\x1b\x0eH\xf2\xa4\x1c\xd2\xbe\x1f\xaa\xb0r\x1f\xc91>\xfc\x8f\xc9\xa9\xb6\xe7\xbb\xf7\xaeCF\xa1#+:\x91k\xd8\xfd\"\xb8\xbb\xbb\xaa*\x80\xc5b\"\xb4R\x03\xbf\x02\xd7\xa2\xbe\xb9\xa5\xd5\xda\xda\xdft\xd5\xaa\xa4\xa4\xfe\xc9\xcf\x9f\xef\xed@\xb4A\xb0\x14\x9e\x1a\xedC\xdai\x8b\xd3\x8d\x8d\xbf\x9757\x1f8G \x9b\xa5\x9b\x1d\x90\xe4a<\xbb\x7f\xdf,\xbdH+2\xfa\x81\x94\xec\xe8\x80\xd4\xba\xbd\x04\x1dD\xe2\xb2\x11\xb0\xe6Q\xd2\x9e\xa2\x809Oq\x9c\xd3\xf8\xa7\x82\xab\xac\x8b\xd3-\xb9\x9f\x1a\xf2\xf2JJ\xf0\x9a\x9c\xa7\xee#O-\x9bA\x01sA\xda>5t\xdaBO@\x90%V/&&\x1f\xab\x1b`\xa9\xd4^\xc0\xec;G*!\xe0pk\x0b\x05i}\xdf\xa1-\x14\x13\x84I`~A\xd3rJ\xf9{t\xda\x02\xcd \xba$~\xaea```\xde/MM\xc3\xe2yN~~\xb6	\xcc\xf1\x04\x89\xe8h^\x8cp\x10\xe8\x88\x95\xf5\xc2tLt\xf4\xf0p\xb9\xf1\xe9\xd3\x07\xf2\x81\xb4=:i\x81\xe2+a\x99\xcb\xa9\xa0p\x16\x90\xf8\x8b\x8c\xd1\xa6\xce\xec\x91\x87uuaQ\xf3\x0bXj\xec\x83\xe5\xc8z\xe2\xcf\xc33\xc3=\xb9W\xe93\xaa\xa4\xe8\xee\xd1313\xda\x003\xe0\x10\xf8)\xc5\x15\xd8\x0b\x1e\x1e#\xc7\x8f\x7fV\xb2\xafvz\xfc\xf8u9\x82\xb0\xf4\xf7\x88N\x84\xc7\x0d\x0f\xa7\x18[X\x84\x955G\x1f\xe8\xa0\xd1\x1a\x0633k\x0f\x01\x05S/S\x0bg\x03\x95\xb8\xe8y\x07F\xce\xc3\x87uuB\x92\xc81\xfc\x02~\xe1\xd7\xdb\xda$\x95\x7f/\xeb|=t,\xe1iQ~^I6\xbcS\xf8\x0b\xa8ZN{\x86\x98]\xc1\x8b\x81\xcc\xa2\xd1\x9e\xde|;\x02y\xb2\xf4\xbd\xa5\xb6\xc4\xccQ\x11\xb9g\xe1\xe1\x1d\x1d/r\x82\x83\xed	\xcc\x86\xaaZE\x18\xbe\xa2\x1cU`\xaa\x87\xa6\xe3\xf4\x90\xcel}\xed\xdb}\xfd\x1b\x16\xf0N\x7f\x13G_\xdf\xaa\xa8@d\xcd\xc1<\x8a\xc1\xa94m\xdf\xa7\xc5\xc5\x08s\xb3\xcf\x18\x1e\x1a\x1a\xb9\xa1\xc4K\xebu5|\x96\x9f\x9f7\xfdx\xcb\x96\xc3\x06\xef\xfb+*vX \xeb\x89\x0d@N\xad\xec\xc9o\xa9\xb9 4\xd3P\x7f\xc7\xf0\xb2\x8f\xb3\x8a\xa9iW\x97\xad\xed\xc6\x8d\xbd5\x08B|\xe1{\xe1\x1fQ\xa89?%\xe4\xce\xb1\x87Z\x1a\xb5\xf1UvE\xf7\x8bZ\x1a\x1b\x9b\xa2Np\x9e\xe2\xd0\xbd\xf0w\x95Q\xf9\x1e\xdd\x13\xc4\xed\x112:WV\x86i8\xdc:\xdc'\x0ft~?\xac-\x11\xe1\xe3m[\x8cQ\xc3O\x0f\x11	^L\xbf>9\x00\xa0H\nr\x0cI\x17=\xa8%L\xfa\xb9\x82\x80\xde\xe9\xa4\x90\xa9y\xd6\x8b\x17\xe7\xfa\xe4\xc1\x81\xdf\xcb\x1f\xc4\xc4\xac$\xa5\x8d\x8d\x19+9\xe7\xd8\x07\xed\xdd\xbd\x02\x16\xb9\\:2\x18\xb5\xa5\xb1q|<\xc7\xb9\xecVA\xf3\x81\x8e\xa7y\xb9\xb6\x99\xb040\xae\xae\x03}G\xb6\xbc\xef\xaf\xd1\x95]?8\xbca\xfd\xfau\x08\x83\x13\xdf\x8cHC\xd7(\xb7\xb0\xb0\xb0\xc4n{U\xd5\x9a\x91\xa1a\xf7\x8bwaD\x08\xbd\xd3\xd8\x88/LQ\x88\x8c~X\xdd\xad\xa9\x15\x12\xb2t)\xa2I_\x80\x8f\xf0\xb2\xa5%\xbf\xfd\xdd\xbb\x8bX\xdd\xa0\x7f\xfeYY\xb8\x04\x8a\x94C\x87ri\x03\xce(	\x96\xb1\xf1\xf6\x96\xa9wG\x95v*\xeeq\xc8\xf5#\xad(\x14\x84\"\xb7\xa0k9\xae\x189\xec\x8c\xa4Ks#m\xc9\xc9\x08\x86\x91\xfc\x02	l\xda.\xfc\n\x90r\x08\x1d\xcb\x05k`\xe1a\x1aP\xa1\x1c\xd7\xa2\xaeq\xf9\xb2\xf7\xa6\xf7\xd1i\xca\xafu\x07\xfb\xd7m \xe1~\x81\xf1\x88\xc3\xf7(i9E\x0b\x9d\x87\xb2-?\xfd\x15\xb7m\xb5\xf7Lc\xa3\xc6\x9e\x03\x92<\x14c7t%\xefB\\\xaf\x13\xb7\xa7\xa6FKb\xe2\xdb\xfd\x98\xccz1c\xc71\xcbO\xce\xf2 1)\xc9\xd1\xd7\x97>D\xa35\xd9\x13.\xab\xe2\x85\x00\xa0\x94\xd3\xe8\xa2\x00`:\xd0\xb4\x9cR\x14V\xberL?1\xc5g\xe8\xfd\xed\xdb%%\xeb5\xb6( \xc2\x0e\xd8\x8aLm\x99\x19\x9a\x96CI\xdf\xf1@1\x86\x9c\xa7L2\xb3\x9e<\xc9\\\x13\x96\x93s\xfd%+(\xcd,\xed\x9a\x12/\x14\x91\x92\xbc\xf9\xde\xc0\x08\x07\x1b\x8a\n\x0f\x1f\xae\x8e\x17\x83%N/\x81U\x08\x12lm\xddqo\xfb\xbdS\x17\x82<\xce%X1\xc7\x9a'z\x13\xc2\x80\\1:\x94S\x8a\x10\xbb.\xdf\x8f\xe2\x7f\xae\x00\xd2\xaa\xb7|w\xca\x8c\x0e\x15\xfaz\xba\xf4\x0d5\xdb\xb3{\xe6\xa7j\xf7\x03\x00\xb0\xe5\xf0>\x85\xfe\x81\xe6\x7f\xf2\x83:\xf1\x08u\x0d\x8d\xcd~\xa6]]\xf16\xd5\xe7\xc9\x8cK\x0e\xf2\xc8`\x1c\xe4\x93vC\xe3\xe5 13--ic\xf4\xb1\x14]v\xc0\xc5'\xf9>\x17/:mB\x9e2F\xd0(<\xce\xf5\xf2\xe5\xcb\x81$\xb3\x071;>5\xb7\xb6~\xdc\n#\x02\xa5\xe2\x03\x12-qR\x81w\xd7\xb9\x97N\xa7\x9d\x85\xb9\"Dp@\x86\xda\xe8\xe6\xd6\x1e\xa4\xaa\x9aCb\xceV\xbc5\xec\x93\x00\x18\xdb;\x15	^\xce\x02\xff4\xbb5yY\xb4\xb6\xfc\xd3l\x86p\x0f\\\x0b\xc2\x8a\xae\x92\x02\xe6\xf2	\x0bbl\xfa\xfcTiH\x84\xac\x86\xd4\x94\x1fI\xa6P\x10\x9c \xfaj\xdf>\xb2\xa9\xb0\x88hS\x80O#\xb3\x95\xd9\xe3\xca,dU8?\xe4I\x9e\xbbhp\x0e\xe9\xde\xfd\x12\x9a\xd8r)J\x99\x83L\xb0\xb5\x86\xde\x97\xc1\x12\x9c=\x82\xaa\xe9@\xd7\xe2;x\xae\x10\x0f\xa3&\x9dg\x18\xc5\x05\x05\x94\x05WTU\xe7\xe0\x1f$\xe6\xf0\x0d\x9e\xf7\xf3\x81\x13\x89\xdf\x00:\xe5h\xd7\x91\x15\x00`T\x92O\xe9\x10F\xed`\x08\xebtH2\xc9hr\x85W\x14\xf1\x8b\x8c\xd1\xabU7\x1fR\xb3\x8d\x0d\x0e\xcf\xcf{|\xfa+\x16\xdet\x10\xcb\x8f(\xad\x7f\x1aJ\xa8\x85\x07\xfc\x82\xceZ\xa0T\xc4\xb8S\xadP\xf0\x1c`\xc2\xad\xacN\xa5\x8d\x8c\x1c;\xef\xea\x1aP\x9c\xe1\xe6\xf4\x991\x07\xdb\x0e\xbd\xbb\x08\xb6\xe8V\xa2\xe19\xd0?\xaare\xe4\x93\x1ea\x8f\xff\xb0\xd5\x88\xab\xb5\xb5\xbd\xbd\xe6\x06D\n#\x88\x14t;PU]\xfc\x0c\xba\xaaS\xc6\x10\x1e\xc1\xb8\x86\xae\xea\xb1j\xdf\xf1A\xcb\x11\xf3F\x8f@#-\x00g\xa5\xa6~\x19\xbf)\xb2\x7f\xb27>\xe2\xd6\xd4t\xf8\xad[\x7f \x07\x1f\xf1w!\xd3L\x94P\x8b\x9eY\xee\xb3\xbfO\xed\xdb\x87e\x05\xe0N*\x91\xfd\xd6\x14\n\x86\xa6\xc3\x07\xd9\x0c\x0b\x93\xf5\xa2\x96\xba\xddj\xf8\x9ec\xb0\xf2\xcb	\xc34\x06\x93\xbc(\xf4\xa2g\xf6\xed\x1e`Y\x13($s\x9b\xb89:\xe1\xe1\xf8\xc8\xb4\xd5\xdb$z{%%\xb6w\x17	\x19\x85\xdd\xb2\xcc\xb4\xc3^S\xe2MT\xf8F/\x18]l\xe6\xb7\x0e\x1e[@o\xf2\x95\xe3M}\xed\xc66UM\xbf\xb1S\x04vU\x8c\xa9)\xa2\x0d\x11/\xe0Sq\xe29\x9a\xd1\x107\xff\x1fz=:ml\xb98\xa4\x12g\x04\xb0\xca\xe8\xfcI\xf6\xf7`>\x000W\xd1\x9d\x84\x86F\xcd]\x7fV8-\xc0|\xd0\xe9\x12\xee)\x99\xcddG\x7f\xeb\x00O\x927\x8b\x9bW\x1d\xb7\x8d\xdbP\x81??\x106V\xe3\xe7w\xd8@\xd9\x06\xa1\xa9\xd2\x9b\x11\xa1\xff\xa2-\xc7\x1f\xfe\xb6<@\xcf\x05Z\"}Ol7\x05\xdeUX\xc7\xa17\x13\xcb}h\xcc\x0d!\x16\xcf\x8b\xe4\x97b\xb6\x8f\x12\x0e\x89\x02\x80\x93\xc6\xf4\xf1\x03\xe8\xc8\xf6{\xe3s\xfe3o\xbb\xb5\xf2%%\xd7\xdf\xb2\"\x9d\x16\xb2\xe5\xd77\xd6\xbe\x7f\xe4\x95c\xdb\xbe\xa4$I\xe5\xa9\x99\xf6\xe2\xbd\xeak\n\xfb\xe2W\x87ML\x08	!\x01\xd0\xb2\xc3ON\x135\x0eH\xf2\xd4\xad\x8a\xd8\xdcyS\xdfx\xf4c\xca\xc6\x8d\x81\x81\x1a\x1e\xc7N n\x1b5\x1c\xd6B\xfd\xc3\xc9\xe3\xb4\x17\xe3\xe3=\xe7u\x08\xec\xa0\xcc\xbc\x9c\x9c\xa2\xf5\xb0\xf68]\xea\xfb\x14}\xf5\xe2t\xcb\x14l\x12.\xee\xb9\xb7\xdcv\xb2|\xb1\x83\x97\xfa\x1d&Fx\x00w-\xed\xd60\xf0\xf6m\x8bU6F\xcbz\xeb\xd0h\x94\xe1=\xc3>y\xb9t+\xeb\xf7\xfd\x0f\x1f\x1e;\xef\xa7\x1958$-##-l;\xe9\xbd\xd8\xdf\x1c\xea\xfd\x85\xfe\xe5\x84k4\x0d\xe2/\xdf\xfa#V\x17\xc1+\x8e\x9f\x8f\x1b\x1a\x1a\xbegx\xcf0MM7\xff\xe9\xc9L\xd6\xec\xd0\xdc\x04|\x0c\xd4Q\x8e\x8e\xa6\x14\x9aI	MG7z\x1cRL[{en\xbc13\x80e\xde]W\xaa`\xec0v\xf8IG\xb5/-\x13\xcd\x0d\x99<\xe7\x8eF\xe7\xe4~\x93\x1a\xb3\xd4\xa66_\x1e\xeb\xac\xadm\xce!\xb3\xaa\xa6\x06\x13\x82\x01\x80,\xdf\xc1z\xfc\xdb\xc4VQ\x0c\xafLY\x99\xc3\xee\x9c]\xb4\xad\xb1yg\xd6\x02 \xed\x0c/N\x1c\x8aJ\xe63(\xab\xacg[(\x8d\xf0\x84\xbf=Jz\xbe\x14\x80\xc4\x97\xf0c:w\xd0,\x9d	\x1a\xb9\xd5,Y\x1c\xd4\x08\x9a\xe6\x1dME\xf5n8\xb1R\xca\xd2\xfc\xbc\xe9a\x0dU\xd5\xd6\xd6\x88\xf8'O\xaey\xf0$\xa6\xa3\x84\x07W\xefC\x8b\xb1\xc8\xa5\xa3\x1b\xcf\xd8c\xf0\x9a\xe8\xfb\x17\xabfQ\xf9\x06_7\x94`Nbd\x92\xd9\xdb\xde\xd1\xb6\x1dQ\xa3\x9f\xecl.\xaa\xb9NY)\xe2W\x88\xef\x1b\xb3\x94_\x11qy\xe6\x8fp\xbb\xe2\x0f\xed\x84\x89\x9e\x0fH\xcc\x0cs\x01\xd9\xae7h\x06\x16J\x1d\xf8\xdf\xe0\xd2\xa5\xf1\xcc\xc5!\xa8\x13FP~\xd4\xff\xb1\xc2\xe4\x82\x95[\x91Y\xf3\xeb$\xf2\x01\x8a\x7f35h\x1e\x7f9\xd726n\xdbjQQ;\x12\xb5e\xb9-\xbf\xbe\xb9\xf5 EoK\xe4p\xcc\xc3\xea\xee;\x15/^\xb0X\xb5\x87R*GF\xc2$\xcb\x1c<\x1d\xbd\x1f\x9f\xfc\xd8fm\xdd\xfeQ\x10n\x8an\xac\xf5\xcc\x10\x81[O\xd4\na\xb6\xcb\xf8m0~\xedJ1\xd9}\x9a\x0f@3H\x02\x06\xfb\xc2\xad\xe2\xeaLo\xee\xe7\xbaN\xcd_h\xa9\xcfO\x08\x02@\xbd\xdfe\x01\xc0\xec*4\x92	\x1f\xf2\x95c\x96>5}}eFL\xa6\x06\xf6\xc4\xf1\xebJ\x9d\xb6?\x80\x07P\x17\xe2\xaf\x06\x1d\xe0\x1a\x0f^\xd5Y.\xf2\x85M\x1aP9+\xd4\x85\x10\x1a\x96	\x8a!\x0fMG\xf1D\xc6\x12\xcc222\x06\x07\x93\xeb?\xd9tTS\xa9\xaf\x0f7\xb7o\xa0hq\xd2+\xed6\x87>\xfc\x17sF\xbc\x02\xc8\xd4\x1d-\x16\x97\x98\x8e\xc6\x1ae[\xd1\xfc\xa8\xc4\xed\xe8Q\xbbs0\x9d\xc0\x19\x92\x02\xae\x0c\x9e\xd6a\xa7\x8f\xf7uD*d\xfbnl\xc4\x89i\xd2\x1b;\xb3{z\x1e\xebc]\x03\x18\x91\x95\xcdCQ\xf6'\x8e]S\xea|\xfe\x11- \xad\xc7\x8f\x9e\xb0\xa1\x94\xc3\xbc\x97\xb2\xadsl%\x8e_\xdb\xaeS1\xba\x96g\xb4\xe6\x9a(\x80\xdeW\xc0+[U-D\xb9\x9a\xd4\xb9\x84V,\x16%\xcdcP\xb6\xd9\x00\x80X\x19\xf8H\xc5\xea\xa1\x85/\x12\xd3Q<\x80WGO.\xf2\x16\xc6fV\xd3\xc3Q\x90\x98\x1f\x00\x03\xce\xb8\xbf\xba\x0f\xb7b\xcf\x9f\xcf\xcb\x97\xd5=sfY\x1b\xaf\xbe\xb9i\"\x89\x94\x9b\xab\xa1\xbe{\xb7\xe6\x83\x13\xc6'\xec\nV\x16\x86F>9u\x85~\xf6\xca\x15/\xd5x2\x81|\xba\xb5\xb9\xb9M\x04\xd6z\x95\xbd\xf8\x91wz{\xfc\x87\xfd`\x9a\xb5'\xc6\xf0:\xac\x07H\xdcE\xef\xf3\x9a\xc5P\x10#\x8d\n\x05\xf2'\x92PB\xc0\xdf#\x08\xb46\xf8H\x89\x1f\xed\x1eo+22B\xc0\xc9\x7fj\x9e\xc5B\xc08]S\xf3F\xc8\xd2\x1aQ\xd1 \xe6\x94\x85\xc6\xc1|\x8a\xee79\x97\xb8\x8e\xff%\x08A^\x81\xa7`\x0d\xf3\xd5\xd2\x11\xe6\x03\xe2\xebZ[\x8d\xe3\xb3\xb2$\xc2rT\xaf\xbf|\xf1\"\xa3\xc9\xda\x1a\x86\x184V\x1d\xd4\xf2#P\xc0\xf1|(\xd9=\xfbEqf\xdao~\x19\x10^\x80\x92\x1c>.\x05zf\x88\xee\x1d\xbb\xd2\xb0\x87\xce*v\x8d\x89|\xdb`D1\xf1\x9cZ	@y\xf4\xc1s\x00\x84\xdf\xe6Z<\xb4\xd2\xed\xdc\xd12D\x05\xb2\xff\x82\xed\xf7\x0b\xf3\xedb2;\x95\xb1\x08\xa86\xb9ji\x15\x15\xc1+6\xdc\x12R\x1d\xb7\xee\xcdy\xa2m\x8d\xad\xbb[\xe5y\xd3\xb4\xdaC\xf4\xecL\xee\xa8\x01\xc7!\x12\xd7\xe3\x16	\xf6\xfb.V\xf2\xe9\x99\x9d\xff1\x83=\x97\xffe\x06\x1c\xaf	\x8ap\xe2\x1e	\xa4\xed\x7f\x84\x19p\xdc+\xd9O\x8bC>\">\xfe|XE\x197\x0f\x0f\xc3\xc9\xc7\xaf\xcbu\x0bb\xab\x82\xe6\xba_\xe9\xf7\xc9\x87>Du\x0c\xf4O\xa2\x87\xfa\x15\xa2\x91\x01\xae\x19l\xa1\xac\xa0\xbf\xe1\xd7Y\xebu\x90\xc6\x8c^\xcf\x0b\xe8	\xb0\xd0pN\xc5\x1f\xa40\xdb\xed\\EdC\x84\x12\xa0\xa4\xce\x03[\x01\xd0\xe1\x87\x99%\x15-\xc8\x03\xa5\xa2\xa5\xa3_\xf4\x9fZ\x0c\x05>\x9a\xdf\x81\x02\x8e\x91\xa2\x87s\xe1+$\x84A\x96;%\xd02\xe9<&\xf7\x8e\x89\xc6i\xa9\xfeYeW\xf4\xb0\xa8\xa5\xf1~\xd5\xe3S\xf0q\x0d]\x89\xcc\xfc@6F\x97\xfc\xf4\xe4\xb6v[K\xab\x8b\xaf\\\xa7\n\x17\xf2\xae\xe6K\xa0Y(\xd6_\\k;\x00\xa1\x02p\xef\xack\\8\x0b	\xd6\x90O\xf6\xe2\x90\xbd\xd6\x19^\xaa\xdd\xff\x89C1z\xd2\x91t\x9b\xb4\xbd\x8e7/\xa0\xaf\xe2\xd2U\xd0\xc5\xaf\xa0\x97\xa0%\x11\xe4\xd2\x1f\xc4\x14jj\xdd\xe9\x7f\xf7A\xb2+~j\xf2\x8fp\xbb\xe7\xe2\xb0\x16#\xa6\x12\"|\xee\xe0\xc7\x99i\xc7B\xefK\x05Wb\xb6\x1f\xfe\x13\x00\x06\x11f:\xf4y\x17\x9a\xa0o\xa0\x1a\xed\xc1vZ\xea\xf3\xc7\xcb\x01\xa4!m\xce\x07bO\xa1\xb8\xe3P\xdf\xcd\xf2\x957}\x9f\x149\xe3';\x93Y\xac\x18\x8d\x94\xc7\x07$y\xca\x0f-\x12\xb9\xdf\xc6/\xfc\n%\xaf\xa6\xb3'X\x00@\xa6\x7fs\x8f\xf2\xe1\xc4\xdc\\,9:\xba\x99\x1a\xc4p\xfau3^H\xdc\xfc\xf8\xcd\xe9\xe9\xc6F\xea\x87W\xaf\xfa\\4\xf7\xed\xbd\xd2m\x88t\x0do\x11\xb4*\xcb\xca\xf6\xcc\x19\xeb\x1d\x12\xce\xceB\xd7\x99\xccSi\xa7\x96\xc1=\xa3\x14\x0e@[\xd3N[X45\x19%\x17\x14\xbc\x8c#\x91n\xdd\xfa\xa7\xfd(\xe4\xf3\x01\xa1\xbe\xe4\x82\xce\xf4\xff\x82\x00\x9c\xc8\x9aB-Z\x0c@\xdf\x1c-\xc7K\xdb\xf4\xb1\xc5\xca.h\xfa\xc9\xf3\xdd\x1a\xd6[\xa5\x1b\xf3\xf22\x1a`\x87\x02=e\xcd\x89\xc1)\xbc\xfd\x06\nf\xcc\xbf\x03\x05\xb4M\xa8i\xea\xef\xb1\x02\x9c\x1a\xbc\xd9\xf25\x8e\x80&\xe8+\x0c\xa4\xf9\x1eGS\x16T\xab\xf8\x01\xe6(\xf2CE\xd8\x83\xabI\x9d\x87\xaa(\x9e]\xe3;i\xa9\xcf\xb5\x85\x80\xdc\x00\xf2K\xd2w\xc3\x03\xdfd2\x83\x16\x07V\xcaFu)Z\xae\xee\xc7S\xfc\xfdT\xa2\xfb\xfbm\xb2r\xbc|}q\xbb`\xf0C<:=~\xee\x1c\xc0\xe28\xb4\xe4\xd4w\xe2\x06\x10b\xfep\xc7\xbe!\x05\x07\xa5\xd0I\x01\x94d\xb2\xbd{bf\xe4^\xa5\x8f\x87\xb2\xd2\x1e\xa9\\\xbfL\x89B\xc1\xd0\x87\xe8yC\xc2\x9bG<\x80\xb6v\x1bZ\xd6\xd0\xa4\xa1n\xb7\xb6\xb6\xaf7\x8e>H$\x17\xae+\\B+\xb5\xfa\x86\x15\xf8\xf0,b\x05\x8a\x16\xb0\xf6:\xec\x86\xb5\x97\xc6=\x0eP\xcaQC\xd0&\x0d\xe89\xc8\x94(X\xe0@\xf4\xd3\xd5\xa4N\xca\x06\x1a\xe1\xd7]\xcb\xa5\xd7\x04\x9b\x00\xe8)\xbc\xcd:\xdb\x89\xfa\x02\xd4x\x0by>F@\xd6$o\xf8\xef;H\x00\xe8\xe8,\xed\x03@\xd6\x06\xa58D\xce\x0f\xb5z\xa0\xcetgu\x8d\xf2N\xb3\xb7>\x92\xf7%\xd6g[\x91\xa8\x82\x9c\xd0\x02J\xe6\x91\xb6I\xd5\x05\x80Y\xb9\xed\xf7\x96\x8a\xdd\x8f\xd2@\xca\x0c\xdcr\xe3\xe2\xda\xda8\xe7\xe4\xdb\x8a\x06(\xf2,7\x1cR\x10\xd0\xfe\xde\xed\x02\x00F\x85\xdbp\x01?N\xc5|\xb1\x7f0\x8a\xfd\xcf?X\xda\xf8\xaf\x7f\xc0I\xb9\xd0H\xdc\x87\x0bx1\xb6hq9X{Q\xca\xb481J_\x83or\xfa\x9f^\x08\x17\xe7\xa2\xe6\xe6C\xcb\x1c\xb2\x7f\x84\x17\xe8M\xc24\xb0x\x89A+e\x05\xee\x99\x88\xf8\xb6\xf3O(\x0b\xaa\x8eK\x80\xaf\x06\x8cB\x84\xdf\xa2\xc4\xf8\x0c\xca*Cx\x12+\x0f9RL<\xeb\xd6\x02J\x8ds-\x00\xf4UhY|}s#\xa3\xed\x0dmqHb\xc5\xe7+}\x82f\xcc\x17\xd3\x82\"\xf9\xef\xd0\x02N\xfa\x86a\xcc\x15-\x00\xb3'\xb9\xd7\x98/\xd9m\x87\xc5\xf5/#\x87\x04\xa8VhZ	Edr\x1f,\x80\xfe\x14\x96\x02\x90i\xbaM\x89\xbf\xcf\x85\x0b\x88-#\xda\xb5\xb7\xeb\"\xb277(\xa7\xd3i\xcd/^\x10\xc9\xac\x81\xf3}\x12 <\xec\x9b\xe4\xcf6i\xfa\xf4\xca\xb1\xfb===\xd4\x0f\xfa#\xe3\xdd\x1a\x16\x7f\xc1n\xcd\xec\xc7\x80\xbb\xbc\xe0\xc4m\xb4\xec\xab\x9eYn\xae\xa8\xb0\x93JsI\x7f\xbf\x8dR\xb4\xb4\xf4\xd0\xa8\x98-?\xc5\xf3\xd9\x8f`\x81l\xf0~>\x80{\xb9W_ \xfc\xf7\x83N\x80\x1a\xe2\xa9\x883\xfb\xc3\x8d\x07z_\xfa\x88\x07`\xb2\x8b{$}	v\x13B\x94\x10\xda\xbc*-\xf59K\x18`\x9a\xa5\x07\xf9\x80\x82!\xf7X\x10\xeb\x84Ru\x84\xd0+_\xf9\xba\xf7Z\xd3\xfdo\x8e\xd8\xb7\xdfZ\x1f\x19	\x1b\xc0\xf0\x1b\xfb\xf9\x80\xf8\x95\x1f\x88\x03\xa5T.J)\x86\xa6?\xd6\xf7\xf7ws\xc3\xba:8\xe0\xd5\xe2\x1e\xc6\x16_\xda\x8c_\x01(E0\xcc\xc8\xa7q\xcb\n\x00f\xf8\x9b\xd8\xa1\x8f-m\x03\xc7\xe2a\xb3N\x0c\xfe[\xd1@i\x85aF\xde\x91{\x07\x010\x9eefvw;\xd7\xfc\xbe\xde+\xe7\xfa\xcbs\xeenEV\x1c\xe2\x83\x96E\xa0!\xc9(y\xda\xe2\x1c\xc2\x0d\xff\xeb0\xa5EK{\x15\xa3Vi~\x0f	(\xb2\x8e\xb5\x00H\x9b\x86\xbb]\xddH{.\x01\xadz\xfbY\xd2\x97\xb0\x85\x0c(c\xf0y\x97\xdeh\x97*$\xbdF\xf9\xc3\x12\x9a\x1an\xdbz\x9c\x99\xcd[\x01 m, \x02\xf4\xcer\x1d*\xe0\x07\xb2\xc6\xdf\xd0\x82\x86\xef\xd0\x02\x8e7\xf7\x82;V\xa0\xe7\xfb\x03\xea\x82+\x841F\xd6|\xb1wP\x14\xf4\x1d\xef\x00\xea\xca\xe0\x1e	h\xcfv\xb9\x00\xa0\xc3M*!\xc1\x1a\xc8\xf956\x91\x17<\x82\x82\x06\xa7f\x8ct\xb5o\x959z:\x07SZ\x8d^Y?\x88\x89\xf17]UP\x19\xd5n3#Q(\x080\x15\x01.<@'\x94{$\x00uy?\x80\x04\x94\xa3\x88\x02\xac[\x9aD1\xf1\xdc\xb5\x1c\xc8\x86\xfc\xa2\x823\x13\xbe\xcd\x03\x89\xbd\x80\x91\x80\xd7\xceV\xa8X\x97\x98\xb5\x04zj\xb3f\xa9\xf4\x1a\x8b2\x1e`PV*\x00h\x1b\xee\x19\xce1H\xfe\xdd\xe3q\xadaa\xb7\x0eg\xdae\\\xe7\xb8\xff(\xf9\xf9W\x0e\xd9\x96\xdc\xbb\x08r~0\xaa\xe6\x9fDKb'nG\xad\x1a\xd0u\xe7\x8e\x17 N\x1c\xcd8\x15\xa5h\xc9\xa4\x01-\xb5K\xebM_\xec\"\xd4,\xf9\x8f\x17\xa86\xfd\xcb\x0bh\xb3\xb0\xdd\x0ez\xc9\xbd\x87\x00h\x81\xee\xee\x8a\xca\xba\xfe\xa7?\xfd\x15\xa3\xb8Z\xd4\xcd\xba\xb5\xc1\x95\xa3\xbf^\xbd\x02\xf39\xcdnM\x97,Z[\xe6\xe7\xeb\xfb\xe1\x9d\x1as\xa8\x85m\xa4]I\xf1\xe5\x1d\xf1\x04\xf3+\x93\xf4\x8aH\xcb\xe2\x15n\xcfwH\xc2\xb4\x16-/(\xfb\x16\xb5\xc4O>\x15\xb5\x00\x16\xa3\x04\x9bJ\x83?)&8\xfee \xe5\xe1\x155:\xeb]\x12\x0f\xc4\xbe\xbd\x1f\xf6\x07\xedV\xc2Pps	`\x19(=\xe5\x0b\xff]h\x08\x80`\x01\x809\xc4='\x00\x98\xf0\xc5\x19\x04\x9d`\x80\x9eA\xa0\x94\x9f\xad\x05 eq\xdd\xc8w@^|\xf3\x0f@\x01R\x1aF\xb9i\xbe\x88\xaa\x8e\xb0\xach\x99eh\xd6	\x8a|\xf4\x03P\xd0\xb7\x0e\xee\xfd\x14J\x8d8ob\xfa\xe2J\x0f\xdc\x9do3\x08;\xaf.\xca H)\xc3\x00\xa6\x16\xa0\x0d\xa07\xad\\\xe7\x0f\x00\xe5B\xf6\x8f@\x01l'\xa1#\x97>.\x95^\x13\x12\x08(\x9e\x8e\xcfD\xa4\xd7$\xb9\x83P\xcd`\x01\x10\xba\x96\xe8\xb8\x84\x1a\xdf\x94\xcd\x0bL:\xac\xf6\xd1R\x9f\x9f\x16\x03\x89o6\x8a\x83P\xe8\x07\xa2(\xd4\x13\xdc\xe3\x00r]\x01rL\xe7>R\x00|\xed\x17U`\xc8\xa5\xa3\xd6\xe9RdU\xe0\xae\xff\xe6\x9a\x13\x80p\xbb\xc5\x01\xb1\xc0\x1b\xbcT\x13D\xcb\x99=\x86%\xff]\xce@B\x9d\xfc\xf9h%\x8e&\x0d\xa8\xe5\x83\xb4\xaef\xeeC\x05\xb4Ix\xeag\xbf\x85\x01\xf6\x9a\xef\xc1\xc0\xb5\xfc\x82+\x0b\xd3\xa5!0VG\xc84\x0e.,E\x92Y\x82\xae\xaf\x1c\xc7|\x9d\x9b\xca\xc2\x0e\x1et\xaea\xcdk4\x11CDy\xa1\xf7\xb0\x7f\xab\xb3\xbd\xdc\x95\xb2\x02\xf7D\x00\xd0s\xa2\xef\xf0\x8d\xd6\xdc\x95\x06\x07\xe0\xed\x10\xab\x0e3\xbe\x9a\xd4Y\x96\x02\xc0\xe8\xeb_	\x14\x13\xcffY\x10pL@\x04\xd0\xf7/N\xb1\x14 1X\xd4ZP\xbdg\xe6\x19;v\xb8\xb9\x95u\x0e]LxJ\xb6\xdfn\x1e`\xc7G5A\x13=\xe2\xcbA\x1b\xf3\xb8I$\xf2\x03\x9c\n\xf71f\x80[	\x03\xc1\x8a\xad\x8b\x02\xd9>\n;\xe8\x8d\xe8\x85\x84)F\xdc\xf3_\xa4(\x99\x1e\x8eVE\xafgv\x19/,&\xf6p\x834c~\x90\xe8q\xc3\x83\x07\xc8I\x84\x95}\xc3\nzS\x17\xb3\x82\x8dk\x0b\x05Ab\xb9r'\xa0Kp\x1f*\x00\x80\xe0\xc2\xb9\xcc\xf5|\xb7\x96\xe9\xd6\xa1\x91\xccL\xecE\x84\xb0\xa1\xd6\xe5\xcbI	K\x01\x9a1\x0eZ\"\xbdf\xa9%\x00u\xbf\xd2D\xa5\xd7\xf0\xf9\x80\xd99\xf8\x87\xf8\xee5I\x94\x85\x89\xdfM\x00\x14Y\xbe\xe6\x1a\xc5\xc4s\xc9/\x80\xe1)\xf3\x0eP\xd8\x7fs\x0f\x94@\xe7.Z\xb9\xb6\x1f\xaa\xe4q6k\xd5\x80\xde\x8f\xe4\x86h]g\xb8J&\"N\x1c&\x19\xa5^_\xcf,7\xef\xf1\xfb}\xfb\xa2\xdfM\xe2\x95\x9a\x9a\xf7jkc`\xe9C\x7f~\xe3\x1fLl\xf9/\x83 \xe9\xfd\xaf\xb9Cb\x9d'n\x93H\xa7\xd2Nm\xbb\xd7\xd3+!\x91\xde \x1b\xbf\x8f \x03\x9f\x8b\xc7\x8f\xc3\xc3\x9dT\xe2X\x8c\x89\xecl*5;\xfb\xf6m$\x1cl\xf0\x03\x19\x84\x00a\x01\x11\x10\xbb\xfc\xdbP\xc1\x85\xe5\xdf	\x15\x80\xd0;?\xe2\x1f\x04X\xc2\x03X\x86\xff\x0em\xa4\xa5\n\x02H\x8c2$\xe3K8?\x03b\x05\xe0\x1f\xb6\xda\xf5,\x93^\xa3<\xc1\x0fh\x07\xbdv\xcb\xe3\xccl\xfe\x10\xa4\x1c\xfd\xb5\x16\xe8}^\x1cN\xf9\xff\x83\x05 \xe5\xd0b\xff\xc0\xd9\x9a\xb6\x01U\xf6\xd4\xb8\xfd|`\x05\x97X@\xb9`\xc1=\x87D|99\x87\xc5\xb5\xd5m\xaa\xb0(Q\xefA1\x9eqo\xf8h\xbd*.@\x8e\x97K,\x80\xf2s\xbeI\x1f\xb4-Y\x94>x[V{\x08\xd0\xcbg\xefIB\x8e\xae\xfe\x0b\xfd\xef\x1b\x1a\x1c<\xbd\x1f\x9f\xc4`|\xa7\xfc\xdcv\xc0~\x07\xea\xf5\x19JQk[\xe0X\xa2\xe5N\x8fw\xd5\xab\x97\x17\xf9\xbb\x0d\xae_\x8f\xe4vP/\xa2\xcd\x1e\x14\x10\x01\xd2\x1bE\x7f\x85\x92:O\n\x00\xe8=%\x8a\x07J\xea\xac\x04\x1c?PZ\xc8.fY\xb1.\xb1\x8a\x1f\xd0\xd4\xbcl7\xe2\xccl\x9a\x04)c\x1d\x9e+\xc0\x8b\xbb\xdc;\x08\xa0cq\xb0\xf6\xfbu\x05\xc8]?ZR\xfa\x0fd\x86h\x98\xc55\xf6\xe8P\x80xq\xb4\xe0\x0c\xae\xdd\x03\x10zcq\xf5\xcc\xc2E\xd1\xe2$D\xcf\x17\xaa\xcc#\xfe\xad\x0bG\x82\x9d\xb4\xe0\x8f(\xe5\xb2P\xeaw\n\xae\xeb\xf2rs\x8b\x19Cg<\x1d=\xf2Nb\x9e\xb8\xbb[dpLYM\xdc\xfa\xbe\x9a\xf3D\xdbJ[w\xb7\xc1A\xa3\x94\x83\x92<\xb8\xfa\xb5j\x00\xf7\xea[Z\x90\xb2\xea{\xb4\x80R\xf1\xec\x07x\x01r'\x87&\x88a\x81p\xa8K\x0c\x00\xc1\xb7\x16\xab\xa1\x99\xde\x1d\xcb\x81\xb4b\xb0\x00\xa0\xde\x964\xe35(\x13\xda\xce\x03h\xb3\xb8\x9b\x9bpf6\xf2\xcb(\x0b\xf0\xa2\x15\x7f\x80\x17\x00z\xc8b \xae\xe6\x81|P\xcbn\x13_<\xe2\x81\xe2\xb9\xaa*\x10\x04`\xb6\xe0\x07\xa2\x86\xe1\xca\xc1\x02\x80u\xe6\x9b\xa8a5?z\xd4\x10\x00_{\xee\xf3e8\xe9uj\x80\xae\xcb]\x06\x01\x80\xd9\x93\xdfF\x0b\xaa\x17\xf3\x02\xe3u\x85\x82P\xd7K\xe5(\xf0\xeaGh\x01}7\xda\xf5R=3\xf4\xda\xf7_\xd6\xa9\x81\xd8S\xa2RPR'RO,;\xd7\xc9\x8f3\x0b\xf0\xe4\x05\xc1\x02\x90iy\x86\xdc\xd5\x99^\xf3?\x01\xa0\xd4t\xb8\x1a\xd1R\x9f\xb7\xac\xa4\xe1\xc7\xc5\x85hf[\xb9-8\x14\x84-\x14\xf7\xac\x00\xb1O\n\xa7\xb9.0\xe2\x07@\xbf\x95;\x8f\xd2\xa0\xe6\x11\x0fM\x1f\xedz\xd3w\xa0\x80\xf6\xf77\xd9D/\xe1\xff\xc2\x86eC\xff*\xb9A\xe9#\x1e\xb9\xf1\xe9i\xc3d\xc3d\xb3\x07\xf1\xd6\x96\xa9\xa7	\x01\xd3\x8c+H\x12\x17\xfd\x1a]J\x19\xf7%F\x88#\x17\xfa4J\x19\xdb\xf6\xb6\xd7\xde\\f_\xc0\xf8&	\xec+\xa9\x91\x87\xb0\xf66~\xfat\xb7\xef\xf8\xad\x08\xc2\xa5\xbd1\xcc\xf1\xca\x9b7a\xd6A\xb9\xf0#.\x02riS\xff\x8f\x88I\xca\n\xfam\x19\x00B\x05\xed{\x015\x9e\xe8\x05\xf4\xa3\xf6\xf3\xe9\xf1\xfb\xf6\xcb\xe3\xcc\x8aa\xf5\n\x95\x97\xf4\xe05(\xbb\x1e\x03\xea\x1cj\x01\xee\xd8\x8f\xb0\x02J\xd1b\x1c\xc6\xa8\xf2\xa0\xbb\x08\x88\x91*\xaf\xe6nc\x01\xa5\xee\x07\xaa\nB\xcb\x84\xa5@\xf8\xcdE*yyf?/V\x11\xbd\xd4\x85>\xc6\xbd\xe1C\xee\x01\xf0\x9br\x17*\x00z\xbe\x83\x83\xed\x81\x1a\xba\xe4\xa9\xf9\x85d\xd9\x87\xaf;\xb3\x9fG\xe2h\xc3\xff\xf7\xde96k\\\xd8\x96\x1f'5\xa7.JKj\xe1>T\x00@\xf9\x8fT\x1b\xc6J\x08\x88\x00\xd9X\xb1\x1a\x8a\xc9X\xc7:\x00hm\xb3w\x96\xe1\xcc\x02*\xf8i\x8e*.`\x16*\xa6\x8a\xfb\x12\xec\xb0\x82\x00\xe0\x9e\xd9\xf0-\x97^\xd3\x04\xf1\x06\xb8\xc8d\xf2HgsO\x9a\x008\xf1\x98{,P\x08\xd9\xcfGY\x91\xca\xfd\xd6\x02p\"\x8c\xab\xca\x02\xc8E\xd5\x05\xf8\x1ap\x9dL\x04\x00\xe7\xf7M:1Z\xec_\x17\xc1\"\xf4_\x17\x01\x89uR\x7f$h\x08\x00\xab\x96{,\x10\x17\x11\x10\x01\xba\x95\xdf\x96\x15l\xe3E/+\x00\x00\x04e\xfd\x80\x8b@\xf1t\xae\x05\xf4\x9a\xf1\xddk0\xbc:\x8fy\x01\x88\xc5\x94\xcc\xf3\x16\xeb\xda\x07\xf2\xcc\xee\x15\x96\x02,\x05\xbbM\x82\xd2k\x94W\xf2\x03\x80i\xb69\xb1\\zMT$/\xc3KX\n8\xfc@\n\x01P\xca\x17\x17\x1cz\x96\xf0\x17'\xa1]:\xbb\xe6T\x0bb]\xb9\x0b\x04\x01p\x0d\xf5\xb2\x14\xba\x8f\x10pQ@\x84\x92\xb68\xc5=\x12~\xf0;qC\x000\xe1\\\xd7\xd6\xf2\xce\xfa\nKA\xa6	\xdc%\x13\x01HtZ|\xc1\xc6GG\xac\x98\xa4_\x9c\\PP\xa0\xa1~\xbb(`\xae*7%!\x0c\xe8\xec$\xef\xe2QhD\xbb\x97/\x97\xdeb\xb5T@%\xfab\xfb$^)\xea\x98\xa8\xe8\xf4\x0c\xe7\x96s\xfa\x0f\x94\x18!\x01O\x85\xdf\xc5\xaeQL\xc6\xae\x8a\x03\x00\x89UI^\x82\x92:\x85\xf6\"\xbe`\xac\xb8]\xf3\xd2b]\xe2\x10\x1f\x00r\xab-\x1e\xf3\x1a\x94\xddK\xa3\x1c\xed\xbc*+~\x8e{\xce\x04\x00\xae\x85{\x1f\xc1WR@D\xef\x95\x19\xf7\x91 \x98xr\x97}\x0eR\n\x16\x90\xe3\xe5>Z\x00\x00\xeb\xd9b\x1fAK\x96\xef\xdf\xd0aa\xc3\xbf\xa1C\xc6n\x01\x11\xca\xee\xdc\x1f\x88\x1c\x02@m\xe3\x1e\x0c\xe8!\xc2R\x90c\xef7\xd9D-\xde\xefe\x13\x01\x90&\xfd\x08\x18\xe8\xeev\x01\xe1KdI\xd0FZ\x92$\x00 \xe5/\xe22\x9e\xd1\x9a\xa6kP\xd7\xebG<\x82\xaf+\xc7\xa0\xa4\xcen_X\xdc7\xc2l\xae&u\xca[\xd00\xbb\\\x80N:\xd7\x97\x10x\x01\x80\xba\x92\x17\x05n;w\x8a\xfa\x1a\xa0\xbdK\xe0\x84P\xb0\x00m#w\x81 \x00 \x17\xee\xcd\x07\x14\xf9\xee\x11\xcf,dW\xc2h%3C\x08\xbe\xcc\xf9\x8e\xd2\xf2\x88\xf5\xb7\xfcHvb\x08\xb9\xa5\xdeuq\x1d\x18\xde\xc0\x1e\xaaak\xc7\xc4=\xac\xee\xe6\xbc-\xe4\xd9\x17-~\xdbv}\x94\x8c\xfd/B\xe6+\x7f\xfb\x08\xde\xc7\xbe\xe0R\xff)\x02\xdb\x9e\xd5m\xcf8\xd4'OsRq\x01\xe1\x96\x056[\xb5\xfd\x9e\x15\xcc\x89\xb1\xe9\xf4\xfd\xa4\x122\x01\xb7\xb6P0\xf4\xd1\xe1'+\xdc\xf0\x97#\x18ss\x1d\xaa\xce\xac\x06r\xa1\xb7\"\xf2\x82\x87\xd8c\x83\x83\xecn\xf2\xbbj\x0f\x92\x1dk\xb4&\xe8\x8f2GOc\x11(?\xea\xc8\xb3\xbc<\xb5\x94\xf7\x841I\x8b\xd7\xa7\xd2lF\xa2\xc8\xc2\xb6\xfc\xb1\xd2A\xea<\xe1\x14\x8e\x1a%\xd7\xb3U\xc9O\x11|\x98\xf6F\xf0\xa1\xb5:\xb6\xe2|N`SV\xf7\xa5\xe1a\xe7\xea\xc9\xa9\xeb\x88\xa4\"\xb1>>\x81\x81\x8eI\xcfw\xc3\xe6p$\x93(\x19\xc6)\x10\xed\xef\x1f\x19z\xe7\xc4\xcaW\xd6]x\x92\xe1\xe6\xe4\xe4\x8b\xc1\xaf\x10w\x17\x10\xa1h\x8d\xff\xb2\x14\xb3]\xe73\x00\x802\xd6QiD1\x19\xeb\xdeLc\xaeS\x03\xd8*\xb7>h\xa6W\x1d\x0f\x00\xc01\n\xec$0\xbcYQK\xa8\xc1\xe5\x7fRjNq\xc0v\xbb\x8fd	\xbb\xe4?\xba\xd0uS_\xbe\xae-\xb0\xfbm\xb2\x11\x93I\x98\x8b\"\x8cI\"x\x0b\xc5\x0fvW\x92\x99\xad\x84\xd9\x90&\xa2\xacN\x00\xe3y]j\xd3\xe0\x14\xe7\x99\xe37\x1f?\xa6R\xc7jX\x8c\x9a\x9c\xe6\xfe~\x1b%\xfc\n\xdc\xa7uj\x80\xfd\x84\x93^\xd5\xb0c\x0d\xb7\xb3Ju\xa5\x1b\xf3\xf2}\xbc\x0f|\x9f7@>\xdf^kI\xdb\x16}\x11[\xe3T\xc2\xb8\xe4\x90\xf85\xa7B\xf1t\xaa\x05\x8ck\xedl\x96.\xb3j4(?'\xe7\x9f\xc2\x12\x19\x98\x99\xa2_\xa5\x03\xb4\xad\xe8\x0c\xd9i\xbc\xe6Fu\xec^\x9f\x91v\xc6\xb4\x05yy\x1b\xaf\xb4\xb2\x80\x08E\xb7\xa5\xc5\x8f1\xdb\x9c\x13\xa5\x8ce\x8e\x8c\xb2K\xec\xda[\xdb\xdb\x11\xe5\xfb\x0es\xa09\xa2\xd6$NnZ\x99\xa9\xa8X\x15\x18H\x98k\xb0\x0f\xa0\xb6q^\xbf\xd5'/\xa7\x1d, 7\xddY6\x1a\x14\xc5.j\"\xca\xee\xf3cl\xc2\x0b\x89\xef\x1b\xb3\xf42\xf52\xdd\x1ex\xf9\xd2dT\xf4\xbb\xdex\xc2\xfc\x05\xa4{\xb9\xbfO\xf5\x8f\xb2Y,\xe6xO\\kX\x84Tvi\xcb9D\xb9\xdb\xda\xc8\x8c\xee\xe40>>\xf2|\xc3\x07\xc9\x04k\xe8Oa)\x9a\xd9\x02s\x94Md\x0f\x92YMqq\xedlVwe\xad\xa2\xde\xdf\xdfy\x9b\x03(\xafC\xbb\x18/g\xc8\xd1\xcf\x10\xd6\x10\x91=Odm\xc7\x0b\x89\xdf\xd8\xcf\x17{JW\x9b\x19\xc1\xee\xc0\xb2^W\xb6C\xf9\xf0.\xae\xef\xab9\x9fB\xfc\x9f\xb7\x04A]\x1eX\xac\xa3\xa3W\x8eel\x8c\xed\xd6\xa1\xa1'\xac &\xbcjC\xc4\xcai\x06yv\xb43\x17\xdaY{\xf0B\xf4\xecuj\x94m\x9cW\x10\xd5\xc4\xb3S\xd8\xf8\xc1\xd3\x99\xa73G\x86`\xc2\x92\x80NX\x80\xbe9ZI\xfa\x8d\xc7\x8f\x9d\xfe!\x05\xe2\xe8\xf6\xecAb\xc9\xb8\xf9\x0e\xcc>X\x16\xbe9\x10\x8b9\xd0\xce\xa8\x1ae\x91\xa7^S\xa9\xac\xfd}\xf2\xb4\xe7n\xae\xaeX77\xa3\x94\x94\x92\xd9\xc9\xf7T\xaa\xb1.sB\x97\xd3y\xac\xda\xbd\xb7\xcc\x056\x93\x8c\xf5\xe8a\x8f\xb0\x98V\xee>X\xac2\xac\xcf\x9b;\xdd\xdd3\x15{o\x04-\xb4\xda3G\xcf\x8b\xe0\xa4\xd4BD\x81\xce\x8d\xde\x1a\xf6\x04\x81\x85eW\xdd\xe5\x99\xed\xfa\xce[\x12(G\xa7u\x83n&~\x1b\xc2\xdf\x80\x93&]\xa9\x1fc\xcc\x11\xe6c\xac\xf3\x0f\xe4\x03\xd9`\x0d5\xca\xb2\x99\xd2\xf7\xecYYV\x0f\xf6K\xeeZ\x82f\xe9\xfaJ*\xfb\xb0\xc5\xcc\x9b\xf8xY\x1d\xaf\x1e{f\x86[\xd3\x01d\x03S\xda\xe6\xe7	s\xca99\xd6\xc4(\xf6B\x8e\xac\xe3\x98\xe5\xa7\x1caZ\x8a\x9a\xc6\xe5\xcb\xdexF\xe7K\xf2\xc2\x0c\x99\xe9\xde'A;8f\x04b-32\xd8\xa3\xec&\x02\x93XTT\xd3dW\"\xdc\xc6+m\x80\xfe: \xc0\x88D{\xdf\x04&\xfc\x1b\xdd\xc0M\nK\xd1\xf8\xfd\xc6:}\xc8\x95\xec\xb6\xc1\xa6\x08\x99}A+\x0b\x97`\xec\xac\xac\xfc\n\xb5\xb4T\xa3\xd9\xc3\x955:\x83\x03R\xeb\xd6\xc9 wu\x00=\xe5K\x8a~;SwBH\x08ym\x176D\x897<jd\xc4\x9e5^_v\xf5\xaa=\xd3u \xacV\x91b\xa2\xe2\x02\xad\xea\xae\"Na\xed\xdeH\x14.\xc1(\xfd\xdf\xc2.\xe4R\xb16bQ)E\xe8/\x9c)\xa3P\xc8\x8c(\xfb\xa0\xe6\xaf\xe7O\x82\xe6\x02o]x=\xa2\xf9l%\xfc\x12\x9c\x0d\xfe2s.^L,~\xef\x84\x05A}\xdf\x86\xc2%\xf0\xa9v\xf9\xfbT|\xa0\xf7\xdcx\x8c.\x91\xc8ng\xcf|\xfa\xd4\xd8H`\x8f\xea\xb2\xd6\x16\n	b\x1d\xbb\xb0\xeciY\x96\x0e~	\xee\xee\xca\x04>(	\xdeF\x16\x9b\x91\xcf>\x1f!\xcc\x88p\xcdm\x8b#\x91j\xd7\x00\x00\x80\xac!\xa9\x84\xec\x83\x13/\xe4\xc5(Q\xb57\xcf1G\xd9\xef\xdb\x19#>	\xd6P\xd2\xb9Zp{f~j\xa6\x9dM?\xf8\x85\x96\xe4\x83\xf2\xca\xea\xea\xb7\x03\xf3\xf31\xf1+\x91\x17\xd0\x80\xf0;^&\xb4\xf8.\x9b\xd5'V\x06\x0b\x84\x16#\x04I\xd0\x96G\xda>\xc8\x10\xfeY!b\x95\x18-b\xc2\x94\xf7\x84@\xb0@\xe8\xd3S\xfd\xa3\xac\x11*\xe9K=\xaf\x10.\x804\xb7m\xacG\xe10\xc2o\xa1\x99n\x9b\xd5\xb3{c\xc3^V\x9c\xad\x05+N\xa7}\xb2\xb5\xb4\x12\xb6\xe5\xd1?\x88\x04\xe4\xb6\xe1\x97 \xaf\xdc\xbb\xa4\xb4\x9c\x11P}\xe8\x97\x01a)\xb9;HU\x95\xd6\xd4\xfc\x8b\x8es\x8ax!q\x91\xa9\xd7/:::b\xe2e8\xb3\xa3^\xf72\xa1\xb9\xef\x95\xbc\x9e\xaa\xea\x02\x1d\xf1\x9b\x9a\xefH*7>`\x01\xea&\x06\x90\xfb\xf7!Yp+_\xc9\xeaCW}\xbalV'V>\xe2\x91\x7f\x8c\xbcqpp\x8a\xc9\x0c\x0e\xaeU\xd4\x9b\xfc\x80\xc4F\xb0Aw\xaey\xc0\xba7[\x10\x1b\xc6;ZZ\xb0U?j?\x9f\xfe\xbb\x0c\x8b\x88'\x82Wg&\xd3\xc7\xe9/\xba\xba9R\xd5\x8d\xf22\xa11\xf7J^\xdf\xaa\xe2\x02\xbd\xf9\xd8|\xc6\xba\xf5#\x06\xb3\xd5\xc2\x8e,\xda\xc6\xab\xdf\xfa\xe2\\w\xc3`f[\xdb\xd4\xd4\xad[\xc8\x0bN\x00\xe1\x0f/\x13Z*\xe6\xeb\x03~L\xe6\x8c.\x03\xde\x94\xbdvd+\xabqSx\xe1\x18jl\x18\xafAe\xc1V\xfd\xb0\xfd|\xfa\x87s\xe7K\xc5\xc8\xec\xc0\xbe\xe5\xb4\xcf\x1f\xb3t\x02\x0b\x0b\xa5\ny\x917\xfey*-\x97V\xecM\x14W\x14\x10\x11\xe7)*\x1a\x1c,\xeb<\xe7\xee\xef\xef\xac\x80\x17\xc2]\xfa\xe0\xda002\xc2\xf1\xfb92\xd7\xfdl\xb3zV<6\xec\xc0\xb3\xb5jz\xfcfH\x8e6a\x07\x14\x99f\x158\xfd\xcex-\xdc\xe5\xac\xa7\xd2\xf2\x00L\xf5\xa1_\xb6\x0bK\xc9\xf1z\xfb\xc4\xb3Y\xdda\xb5k(\x15\xcf\x8a\x16\x183\xeb\x95\x0fZ\xc0k`\xfc\xd2\x9bH	\x81\x85\xf8\xf2\x11\x8f\xfcY\xecLYX\xfd\xf9\xbe\xe54\xa7\x96\xb63\xf63\xae\xea\x8b{\xa2Y\xb6\xb6\xee\x90\xa8\xec\xfe\xf2\x92E!\x9c\x8a\xbaCHniKL\xfc\x1e\xce\xde9\xec\x86;\xec\xe5t\x18\x9e\xfb%]\xcf\x13\x1b\xb0\xb0\x10\x13C\xb4\xbb\x8el]hYl\x18\xafl\x98\x97	\x86\x05w\xa9\x85\xd9\x1aeO\x16\x85[-]\x1a\xe4\xb9\x14VP\xa1\xdeDJy\x87\xcd\xeap\xdd`\x01L\xa3\xa8\xa8\x9dy^\xde\x01\x0b\xa0\x10\x1f\xbf\x87\xc3L\xfe\xe6\x9d\xc50\x9e]\xed\xaa\x81\x87)-\x1d\x1e\x8e\x8b\x83Wg\x91a\xee\xc3x\x86\xbc\x08q\xe9\xfft\xe1\x1a\xd20\x90E6\xbc*6B\x7fj\xd5\xd6&b\x0b\xb7\x11\x0f\xdb+\xb9DG\xa27\x11\xc7X\xa7F\xc9>\x8d\x1d\x1a\x1e\x96\xbd\x96\x05N\x94\xf8\x05\xfa8\x13t\x11\xe5\xd5\xdb\xd9c\xb3z\xd626\xec\xe5\x98s-(\xb7J\xb2\xcbhp\x85\xa5M\xacKmp\x0dI\xd8\xf1\xef\xba\xcb;\xbf\x8e&u\xcb\xaa\xcd>\x99\x12\xd2\x94\xda4\x10\xbe\x0c\xb1b/\n\xb6\xd2=1\x92\xd7\xe1\x19\x83Pu\x87)\xbf\x8dx~\xa4{	\xafS<\x80\xc1\x86g \x11\xa1\xb1\xf9\xc1\x03\xce\xde\xc0\xe6cL\xdf41m\x1b~	\xbd|-\xfc\xab\xb1\x9a\xfa\\\xc6j\xe47ZjY\xd7\xf1\xe3%\xa7\x97\x97\xc7\xec\xe7\x93v\xbb\xa9\xef\xffO\xae:2_\xd8@D\x1ey\x96\xef\xbf\x9c\x8f\xf3[U\xd5\x86\x0d\xc3\xc3\x9cy\x02p\xe2A\xcf\xe3\xf0\xea\xbfSv\xe1\x9e\xc0#6~\xf8p\xfb\xd8\x96\xffF<^\xb5\xa1\xc2\xdb\xf8\x1c\xcdR\xd5\x05r\xccLNN\xc9\xcf\xd8\xf1o\xb7]\xdb\x84\xa5	\x02\"\xb8'\x9cp\xe9\x7f]\xde\xbf\x0f;\xf8_\xe7\xd9\xd8\xf2\xa0br\xfaF\x16\xe7W\xcc\x05+k\x11)\xdas\xa4C\xc4G_y\xf3~O\xcfc\xce)\x02@a?\x920c\xf4l;\xd6'A\x8b\xd8\xed\x02	\xba\xb8\xad_\xfb\xe6\xfc\xbf\x93\xe2w\x1fv\xae\xf6\x1d\xe75\x81\x11\xa6\x96\xaa\xe3\x9bE\xd4\xfdwN\xb5\xd4 \xef\xa7De\xfc\x12z\xca:5=~<>V\x970}\xb1o9\xf23\x14\xf9(\xa9\x8b1\xf7\xe2E\xed\x1a\xe4\xba\x1d\xfc\xf4\xae?\xab\xf6h\\\xf6\xde\x12\xc9\xd1X\x00\x90\x1a\xd0$\x12\x89\x14xV.rY\x1b/\xe3\x82\x80\x88\xf8\xba\x0d\x1b\xc8\xb3e\x05^_\xd7\x98\x98\x9e\xd3\xf5\xf2zBB\xed\x1a\xe4\xbdv\xb7\xeb\xbe\xbc\xfe\xf4\xeb@\xa9\x03#\x05\x01\xcd\xef%BE\x83\x05B\xffp\x1a\x1fj.)\xb9\x7f\xdf\x0c\xeb\xe3\x83\x1cY\x00\xa0\xad\x17.\x8c\x0c\xf7\x8f\xb8bu\xbd\x1e\x13\x95\xf1Bt\xafujz\xaf&'\x19\xe3o\x93\x8d\xfe\x1d\xe6\xef\x94\x99\xb7\x1f\x93o\xd7\xae\xa1\xb4\xeev\x81B\x8f&_*\xa8\xab\xd3O\xcc\xcdM\xde\x8b\x17\xe2,\xd9\x13\xf6\x10\x9e\xa9\x91\xaf\xbc\\Y\xb8\xc4WK@D\xfc\xe8\xbe6\x8b\xa0\xfe}\"\x9c>\x08\x86\xc63k\xb0\xe4\x1b\xa1*.\xd0\x91\xb7\xd3\xc7\xed+m\x8f\xbf\xb2~\x10CJ\xe1A~\xa7)\x14_\xb93\xde\x93f\xd8'A\xb3]\xab\xa6wm\x8a\xf3\x8e\xd4\xaf\"\xf5\xfc\x10\xf3\xe0\xcd\x95;\xbc&\xb0\xc0\xeeW\x04\x8c\x95\xcd\xfa\x9a\x9a\xaa4Se\n9-h\xcf}'&7!\x91`\xb16\xdeb-\xd8\x86\\\x9cn\xc9\xb5Q\xfa\xb7\x8b\x8e\xfee\xb1\xc2\x02\"\xe2W\xda\xda\xe2\x03\xc6\xc3\xec\xf1x\xe1xYi\xce{\xfe\x00\x10?\xa52\xe7|\xd0\xb9\xba\xf8\x8a\x1f\xbc\x04{\x01\x11\xdc\x01a\xd1 &\x91<\xbf\xfd\xb6\x8b\x9b\xbb\xbb\xc8\x97\x86\xf4\xec\xcd+33\x15\x15{\xab\x991\xed\xac\x01\x8b/&N\xc1\x90\xc0\xb6\xd0\xd0ek\x7f\x1d\xaf\xbc\xb1i&\x8a0'IXQ\xc8\x8b1\x14\x10\xc1%r^\x1d\xd0\xde\xc6\xac\xb4\xb7+\\\xfbu\xe2\x8e\xbe\x13N\xaf\xd4W\xe1\xc6\xd7\xaaQ\x96\xa9\xed\xa2g\xd8\x1f\x0f\xac\xa3\xea\xf8\xe6\x91u\xbfJ7\xa4\x9e\xea\xddsl\xcfBO\xc5\x91>	Z\xdf:5\xcaMu\xb5I,\xbb\x860\xa6\xcc\xa1\x9c\x07\xf29r\x96N\xd60\xd6\xbb\xf1\x1fo\xa1'\xc0\xbd\xce\x84	\xb1G\xda\xd9\xd3\xf9\xc7\x8f#\xc7\xee\x8b\x92Q-LM\xfd\xad\xad[c\x14\xe4\x12\xac!\xb1\xcaG<'\xaes\xe2D\x19\xfa\xc5\x99\x99\xb9\x9b\xbf\x8e_\xe4;\xf1\xb7#\x8e\xde\xce\x98\xee\xa9\xaaUD\xea\xebR\x0e \xb5\xc6\x81\x1e}\xed\xacV\xc2\x98r\x0c\x92}@\xb8 l\xb8,\x90\xcd	\xf2\xe8\xcb'3\x1b\xd8\xac\x9e4\x83>y\x9a\xa3\x8b\xe8\x06\x10\xa0\xed\xee\x1e}\x0c;\x1a\xa3\xcb\xa6O\xea\xb2B\xc8s1_j\x158\x83\xd1R\x90\x0c\x12\xfe\xf9Y\xde\x13\nX\x17\xc8t\xa0\xca\x9eQ\xf1\xb9\xc0\x8b\xc9\xfc:o\xd6I\xff\xd6h\xd5\xe6'\xdb\xf8b\xcf\x0b\x88\xe0\xd2\xe3\xb4f\x08\x0cYv\xa7=\xde\x87=\x1e\x15\xa5t\xc3\x83\xa3T\xa1e\x0e\xd9\x1a\xc6J\xffG\x1c\xe2wv(@\x1f)\x14b\x90\xfaTYccWy\xcdCR\xc0\x95A\x1f\x02{fj\x9e\xcab\x05!\xef\x0c\x06\x80\xf6\xec\xc8&+k\xab\x07\x0b\xf3\xac\xf1\x0c\xf2lq\xd1B\xa4q\xd0XX\xed!=_\xd8{\x1a\x18(\xc4\xf73\xe64\x08\xac\xaf<GKkG\xc6\xe0`B\x11\xf24\x14\xb93\x9a\x91\x93\xa0\xea\\\x1d\xb7\xa0Af6\x10\x0f\xe4#\xefY\xa1\xaf\xa22\xea\x9f\x92\x98\x93\xfd\x03\x03\xaf9\xbe\xe6Bi\xcb\xf4t\xb5\xc7\xd7a7\xb9\xb8\x1animU\x8dV&\xc7\xfe\xd5\xf75{\x86d\xfd\xd8O\xaa\xab\x85$o\xdc\xa02\n\xcc\xe6\x8b\xc8E>D$\xb4m\x86\x95\x96\x96\x91\xb9\x91\xc5\x87t\x90\xb8\xe9\xcb\xf5\xa0\xc0=\x9es\x97\xb6L\x94Ivu%\x84A]\xd5\x8fxf\xcf\xb0\xca\xedwrh\x0e\xd1N7`r\xf6(\x12\x0f\x10/\x14E\x1e\xc5\x84\xaf\xbc\xd9\xc8\xb6\xf7\x9f\xa9\xb7\xf6\x89k\xf7d\xcc\xda\xb0{?\xf6\xce^\x92\xb6w\xf8\xed\xa0\xcb\x91S\xbbww\xca\xeb\xc9\xebo<-\x9c\xfa\xabz\x8c\xb7n\xc4\xcd\xc0\xa2sZOe\xae\x1evM\xbe\xaf\xf2d\xe5\xb57\xfc\x8f\xaf\x1eSz\xe5\xb9\xd2\xdeA\xab_>\xc9\xfb\xc6\xa5\xdd\xaa\x9b\xc8\x11\x81vD\x03\xfa\xd8H\n\x91\xca\x8a\xf7\xceP\xef\x8b\xd7\xbc2t\xb3c\xc8H\x99\xa8m\xea\xb7\xe2i\xbc\xa0\x14$;\\#+\x9d\x91!K`T(\xb8\xb9\xf5\xd6\xd8>\x7fJ\"\xf9M4f\xd6\x0fr\xe4y\xc7*3\xad\"\x88\xb0\x0bse\xe0cvZ\xda\xbd{\xab\x14\x0c\x95y\xa7w\xec\xe7\xa3k\xccM\x84\xbcy\xfd\xba\xbb\xb2)\xab\xadd\xaa?%\x9e\x10\xe4\xcc\xec,3\x8a\xf2\xf6ij\xd2\xd00\xc2\xf2#]\xc8\xdc?\x9c\xd4\x94\x91>F\xadK22Z\xc5\xec*\x8f\x8a*j\xbb~\xbd\xb9\xb9\xbe\x08\n\xd6\xab\x05\x0c'\x16\x9b\xefW\xaf\x89\xb7\xf7\x06\x9b\x9a\x9a\xc8\xb3\x9d\xe3\xddUU\x95\xf6\xb6E\x85\xb9W\xba\xdfN\x11G\xdb\xda\xa9\xcd\xcd\xdbH\x92Ho\x1f3\xe6-3\x9a/z\xa7\x17\x14t\x1c3W\xdcY-30%C\x088\x97\x90\xde\xd3s\xa3\xb4~\x10\n>Z\x0b\xb4\xf5\xdb\xa7B\x9b[\n\x83\x02/O\x8d\xb4\xad\xd7hl\xcc\xcb\xc7~z~\xc5\xcb\xdf\xdf}\xb8z8.N+_c\x8f\x8f\x8f\xc6\x9e=\x1a\xf0\xbfyy\xae3n\x8e\xb9\x0b3#X\xf7\xb66%f\x13\xe7\xe8\xbd0\x91\x1d\xc4\xb7d\xb6X\x0e\x07\xb9?\xa9\xeb\xf3\xd6\x8a;\x9c\xd4\x9a\x99~\xde+ \xd1:\xd7*\xf8\xc5\xac~\x86\xfa\xbe}{\x9b=\x8c\x8d\xc5\x04\xe2\xaaq\x18us?\xf5]V\xfc\"z<2\xfb~\x0d\x9cnn\xc5\x92'N\xf4\xaf\xe1\x1c\xcb\xeaHc\xd6\x80\x05\x99\xf1k\xff\x1a\xa0\xad9U\xcf\xd1\x1a\x00@\xc9\xd1\xda\xaf__\xadP\xfb\xfa\xd5\x93\xff\x0b|\x03=\xe9\xfd_\xdb\x8a\x1b\xfcl\xfa\xb3\xe9\xcf\xa6?\x9b\xfel\xfa\xb3\xe9\xcf\xa6?\x9b\xfel\xfa\xb3\xe9\xcf\xa6?\x9b\xfel\xfa\xb3\xe9\xcf\xa6?\x9b\xfel\xfa\xb3\xe9\xcf\xa6?\x9b\xfel\xfa\xb3\xe9\xcf\xa6?\x9b\xfel\xfa\xff|\xd3\x943~\x00\xfc\xd9\x99SC\x0dZ\x88\xf31$r\xfe\x9c\xda\x10\x90\xc0\xf3\xa5\x89\xe0&\x97\xaf]t\nJ}\xf9\x06a~\x13\xf8\xf2U.\xfc\xd1\xd7\xa6\xa1\x1b\x7f6\xfd\xff@\xd3\xb3\x9f\xffh\x18\x98\xca\xae\xdf\x88\xfce\xfa\x9d\xf9\xaa\xb3j\xcb\xa4\xc0\xe1\xea\x8f\x96IT\x97\xfbvH_O-\x1c\xa7\x0e\x93\xea\xb6\x92x!\x83\xe3\xb5`\xd7\xa5\xd7\x03\xb7n\xa4\xe8\"?\x11\xfe\xb8\xa5.\xc5:.tvRP\n\x1c>A\xb2\xbb\xdc:\\\xcf)J\x99N\xb7#n\xb78\x0e\xd5mt\x01k\xf7V\x9d'Z\x98'sJs\x86\xccs\xea\xd6\x8f\x16\xba\xf2\xfe!\xbd\x9f\x8f\xf2\xe6\x82\x9a_\x9bG\xfe$R\x11\x03\x91\\\xd5\xfc\xdb\xde\x9a\xaf:\xfb\x19\xe90C\xfd\xaf\xd8\xbf\xb6\x92\x90\xb2\x8e\xb3D\x1bu\x9e\xc3\x91\xf0\xac\x9fY\x99\x9a2\x83\xbe\xa4L\xfd\x1b\\\x8f\x1b\x8b	$[\x00z*<\xf9\x13E\xfbTU\xf7\x9e\xf1C\x8a7(v\xb9\xad\xbf	@&\x9b\xe0Ip\x92\xaf\xc4\xfc\x07U\x87\xfb9eD\xed):\x8d\xae{b\xc4\xb2\x1e$\xe7\x7fy\xdc,EUCC\xfa\xcb\xda\xe9\x81\x9a\x0d\x8cb\xa3-\x94\xd4\x95j\xe0\x8f\xd4t+mm\xff\xd4/S\x1d55\x17\n\xcb\xc9I\xb6\x00'o<\xe29\xab?\xe5g\x10\xbes\xe7\xdd\xaf\x0f\xfa\x916\x1aHH\x1a\x11\xc1#\xe1\xfd|\x94\xd0\xf4\xf4\x82\x82\x8e\xa7\xcdkeG\xbd\x8d\xb0H\x93\xd9\xc7H\xc2;\xfdALL\xf46\xd2\x12H\x85_\x04l\xccl\xb2\xb6\xd3	x\xfee\x08\xac\xd4\xe8\x86}\x85\xcf\xcc\xfc\x96\x80\xcd\xf0\xdcZ\xf4\xd9\xb3c\xdd\xdd_$\\\x92\xa6\xeb;\xb0\xad\xc9\x08\xaa\xdb\xe2\x02\xd6^h\x1by\xf9r\xe8b\x86\xbaz\xf3h\x14\x15\x91\xd8\xc9\x8c\x06\xd7\xcc\x9e\xcf\x8ftM\xcc'&rr\x92\xf3\xc1\xc9;\x8fx\xa0\x96\xb3\xb8\xf1\x0f\xd9\x8e_v\xe3\x83kn\xcb\xd9\xf1\x0f\xed\xb2\xfe\xffL.\x07oW\xa9\x81\x931\x0f\xac\xce\xec\xf5|\x16\xb3\xed/\x00\x96\x83\xa3\xfa\xfb\xcd\x12\xed\xb0\xf9\xd6U\xf5Hu\x07TgN\xcc\xb7\xce\xec	\x0e\xfb\xb5_\x11\xdcUp\x014c\x01\xa6\xa9B \xa7Ph\xabs\xadn\xb7\xdb?\x80\xa1\xbc\x9f\x0f\x10Tb\x03\xfb\xbb\xef9\x0fL\x9d\xcei\xdeJB\xb6d\xad\xe2T\xf6\xe9\x9c\xe6\x00\\\xf2N\xd2\x12h\xf5o\x02\x80\xf5\xf2\xf3\xe7\x9a\x13\xba\xdd\x8e\x1e\xc8\x96mT\xdf{\xd9\xaf;-\xc5\x90\x08\xe4\xc2\x1e\xf1@\xe4]\xaa\x13/\x85\x8cu\xdb\\\xf0E\xe6\xe6~H\x11\n\x8e\xbe\xef\xf9\xe1\xeaw\xdal\xc6{\xe3(*\xaf\x9e\xc4~>\xd0\xee\x80	\x98\xeb\xbew\x8f#\x1e(\xb8F}%4*\xef\x02h\xd6\x93\x0b\xe5\x11\xca\xca3~\x11\xb2glvrf\x81\xf9\xab\xb0\xad\xfc~O\xaf\xac\xcdF#,\x8f\xdc\xedG<\xd0\xe8\xb3\xe7\xb3\xf3\xca\xd5\xb1iMZ\xc3\xdeE\x93H\x01\x16\x8d\x96\xda\xd4:|z\x90\xde\xa1A\xd5\xf6\xcf\x9b\x94\x00\x97\x96I\x81Y\x9e\x10\xd1\xb8+S\x05\xbd_`R4\xbc\xb9\xd9\xc2\xee\xcats\xfd\x0ep\x04\x16\xc8\xd2c\xc7\xb4\xe7>\xdf\xde\xc8dUD\x96?\xfc\xb2\xf0\x8f\xed26R\xccw\xfc\xc0\xff\x17\x17p\xd6\xb8\xa7\xa7\xfaa \xebz\xba\x95\x0d\x06\x9f\xca\x19\xed,;Msb\xa2\xb4#\xd8\xc8\xb1_\x11\x0c\x89\xfd&\x00\x82\xae%'\x13f\xcb\xe3\xd9\xc5\xcd\x7f\xad\x1d\x9d2\xe4\xe8\xcet\xc8R\xc9\xb2\xe4r\xaa\xdf`n\x93!\x96\xe7\xac\xee\n5@OR\x94e\xa6\x10\x82&\xaa\x10\xbd\xfa\xb2Y6\xb7\x0e7\xf4\xe3elV\x1bay\xce\xb2\xe1f\x16V6\x01\xcc)U\xe7\x01\xc7\xb8\x13E_\xc4\xf9g\xf7\x8bn\x9c\xe7\xd8-\x0d\xfb\xc0\xdcI	0\x9d\x91]\xcf\x07>\xb6\xe7\xe5\x95\xb0\xe6{\xaa\x88%~\xa3$1\x9d\x8fm\xb6\xf6\xed\x1f\xb7\x92\x04\x91mN\xab\xeb\xf3\xcep\xf5\x1c\x93$c\xbc\x1e\xbf\x8f\x8e\x8b{\xa8D\x12\x84\x98\x86\xb5\x80b\xdc3\x11\xc6\x9eW\x1e-)\xca\xf1_\xa8\x9e\xf7\xe3\x1c6JR\xe3\xc5c\x7f\xcc\xf8\x05\x9d\xe5\x85\xe2\x7f\x7f\xc4\x03\xc5\x7f\x8c\xb2g\xcd7\x91\xe7\xaa^\xbf\x8e\xb2\xb7#Yq\xe6B\xdfj\x1677\xd7\x81\xeb\xcf\xce\xa7\xfa\x0d\xe7\xd6[\x03\x0c\x86_\x04h\xaf\xb6\xc8*f\xb3\x08\xac\xf2L\xeb\xde\x1a\xfb\xd9\xc0/\xbd\x96\xd6\x8f\xc6k_\x19\xbaYP\x90\xe3\x1cOH\x98\x94\x00\xa2R\xfb\xf9\x80X\xddp\xab\xa4\x12\xa3C\xcc\x82\x18Dg\x85\xc8R\x8b\xf2/\xcf\x8c\xb4\xd5\x87!R!\x9b\x93\xa8\x1e\xb9\x0frr\x88\xb1W\xa6\x0b\x828\xa7*\x0c`\xfe\x82\x07\x92\xb7\x0f\x9a\xa3\x16\x8d\x92\xc9\xd9c\xedQ\xac\xd9\xf2/\x92$\x1f\xce_\xf8X\xcf`t\x94b\x8b\xafL_\xecW\x04\xd2\x01\xbf	\x00\x994-\x1f%\xbbb|\x7fKC;k\x9a\xac\xf3\xa9\x99\xe4\xe5\xec\x983)\x8f\xa8\xcf\x82\x9aT\x9b\x87y\xd5\xbb\xe2\xd9\xce\xfe\xf7\xc1/n\xae2\x88\xb8o\xc7OI\x15W\x03'\xdf\xddZ/\x1b\x88\x1f\xf9400\xaa\xa3I\x18\xa1\x92\x8aZ[\xb5\x92\x91\xaaw\x90k\x95\xb9\xe7\xb4\xdb\x9e\x07\xb7*\xd2R\x08\xd3>\xe5!\xa5\x92\xf6\xda\x81\x85\x93\xf2\xe00\xac\xaa\x82MD\xe6\x029\x90Q\x94k\xdd[m?\xcb$\xf9\xfb\x8cz`\xa3\xec\x90R\x9a\xa4d\xf8\xb4f\xeeg\x7f\xa8p4\x16v\xb6\xf7\xbb\xd2\x1d]\x1f\x06\xd6^\xfeM\x00t>\xc9\nb\x8c)\xc4?\xc9\x8c\xd3\xf5\xe9x9==Pe\x9do\xa8\xcc\x8b\x1c\x98\xb4\x86\x07\x0f\x86f\x96\x06G\xa6\x84o\xe0`@\xe2\xa4<\x98\xd5\xfdM\x00$\xba_\xb4)	\x18n\x19\xb6\x8e\x0f\x9a\x9f\xeb\xf5iT\xb6+\xc6_\xf0\xf1	\x0c\xf4\xca\x99DJ\xa3>\x12\xa4n\xd9\x11\xd3_\xfb\x1bD()\x1e\x9f\x8c\x90\xb5\xb7\x1b\xf1\x96\x948\xefl4\xca\x0ba\x15\\\xc0\xd9\xf8\xe6O\xc5\x97\xba\xe7\xa7\xa6\xa6\x94K\x9e\x07\\\xf1\x9a\x18\xb7h\xca\xf2p\xb7+\xb8\xc4\x1c\xf4\x9f\xc9\xf7\xf1\xf66$\xc2t\x04\x1a\x1fp\xcc<\x9f\xd3\x9c\xd6\xd3sC\xd5<\xf7)\xc6?\xb5\xc9\x9f1v\xfdz\xfa\x83\x98\xf5\xa2FD>\x88\xb4R\x0d\xe0\xf4.\xba\xdb\x92K\n\xfdfFn\xadwskm\xd5\xf0\xf8T\\@\"\xe5y\xf7NWW\xc7\xb5\xae\x8f)*Z\xbf>\x06\xf9\xd7\xda:w!7\xbb\x85\xe9?\x93\x9f7\xe2\x11e\x87\xf0\x1f\xdd':\x8d\xfd\xcf\xac\xe1\xbf\xb7X&\xd5\x0d{k\xde:\x9com^\xd53\xab\x9f\xef\x9a+\x10<v\xf3\xb4\x9b\x9f\xdf\xa5\x7f\xda\x1e>\x94\x11\xd6\x8c\xeb\xc0\xb9\xa6O\xba\x82\x9f\x9f\x9f\x9f\x9f\x9f\x9f\x9f\xff7>2\xe9l\x1e\xf7\xad	#ui\xa6\x87\x00\x00\x9b\xf1\x87,\xf1N\xe7\xf0\xbfj:^\xfa\xf5\x1c\xfeW\xa0\xb2Ke\x97\xe2\xee\xdd\x8a*\x18\xb3\xdd\x1a\x9a\xbbU5\xd5v\xef\xd8\xb5Ks\xd7\xae\xb1j\xb3\xf2\xffy\xc0\x1d\xebt\xe1\xfc\x95\xef?\x00E\x0e\xf9\xc3#\x1e=tB?\xeb\xc0\xd9\xdf\xfe\x7f\x01\x00\x00\xff\xffPK\x07\x08\xb8zn%\x03<\x00\x00\x1d\x8b\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x1c\x80|Q\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0d\x00	\x00turbolinks.jsUT\x05\x00\x018t\xc2_\xacX]o\xdb:\xd2\xbe\xb6\x7f\xc5\x1c\xbc\x17\x92QEn\xcf\xc5\x8bER\x17\x9b&\xe9&\xdb\xf6\xa4hZ\x9c\x05N\x0fPZ\x1c[DhRKRq\x8c\xb6\xff}1CJ\x96?\x9a\x03,\xf6&\xb1I\xce3\xc3\x99g>\xe8\xe9\x14\xee>\\\xfe\xeb\xe4\x8d\xd2xa\x9b\x8dS\xcb:|\xc2\xc7p\n\xbf>\xff\xf59\xbcF\x83RU\x01\xae\x85\xabl\xeb\x02\xbc\x9c\xa3)\xeb\xf4\xed\xef\xdd\x87\xc6\xd9\xa5\x13\xab\x952\xcb\xb2\xb2e{\xffj<\x9d\x8e;\xf4w\xaaB\xe3\xf1\xe4F\xa2	j\xa1\xd0\x9d\xc2\xeb\xbb\xcb\x93_O.\xb4h=\x8e\xc7Y\xeb\x11|p\xaa\n\xd9\xd9x\\Y\xe3\x034\xc2yt\x0030\xb8\x86\xcb\xdb\xf7\x1fx!\x9f\x9c\xa5\x03\x95\xdb4\xc1\xd2\x81\xb52\xd2\xae\xcb\xb8P\xfav\x1e4v\xa7\xd0TV\xa2K0t\xb9\xab\xb8B@c|l\xac\x0b\xb0hM\x15\x945 \xa4\xbc\xc4\x85hu\xb8\xb6\xf6\xde\xe7\x93\xf1\xb7\xf1(\xc1\x0b)\xaf\x1e\xd0\x84w\xca\x074\xe8r\xc8*\xad\xaa\xfb\xac\x00\x19ex\xfbZ\x18\xa9\xd1\xc1\xe4\xec)\xc9\xc66>\x88\x80Y\x01\x08\xb3W\xf0\x0d\xb4\x15\xf2\x83Xb\x0e\xd2V\xed\nM(\xb5\xad\x04\x9b5\x81\x1f\x84\xf7\xe3\xd0\xe0#\x9as@`\xbb\xa7S\xb85z\x03\x8d\xb3\x15z\x0f\xd6@\xe3\xd4J\xb8\x0d\xcc\xdb\x10\xac\xf1\xe5x\xa4\x16\x90\x03\x96q\x01~\x99\xcd\xe09L\xc0ah\x9d9\x1b\x1f\x82\xack4`,\xac0\x08\xb8\xc7\x8d\x07\xe1\x10j\xd4\x12r\xa1\xb5]\x83m\xd0(\xb3\x04e\xd8\xe5A\xcc\x0b\xc0P\x95\x93^Y\x15\x9c~\x8b\x1b\xf8\xfe\x1d\xb0\xf4\xb5Z\x84\xfe\x1b\xc1\xf6_\x84\xe6\x8d=s\xde(#A\x80V\xe6\x1eB-\x02(\x0fs$\x8d\x1c\x0e\x94`M9\x1e\xc5\xf0\xf3\xa9\x19`\x19\x84[b(+m=\xfa\x90C&2r)#~\xaa\x95\xdf\xbaTy\xba\x82\x13\x81 -\xdd\x9d\x88a\xaa\xda\xba\xdea\xbfD\xe0=\xcb.\xad\xc9B\xef):\xe1a\xadB\x0d\xc2l \x1a0\xeb\x10h\xb7\xac\x85?\x0f\xc1\xa9y\x1b0\x87,\x1e\xc9`r\x1cX\x84\x80\xab&@\xb0{:\x82\x05c\xd75:\xdc\xb5o\x1f\xbfv\xb88\x82\xfe\x0f\x0c\x90\x0b#\xe1\x01\x9dZl&\x10jd\xf9\xcc'\xab{w\x12\x02\xcc\"\xf8\x12\xc3\x11\xf0\x84y\xf5X\xe9V\"(\x13\x9c8i\xc4\x12\xf7]H\xe7K\x1f\x84\x0b\xfew\x15\xea\x1c\xb2\xff\xfb\xe9\xcd\x17\x96\x99\x85\x8f\x01\x9d\x11:^\xbb\x84\xd7XQ\x01\x01\xbb\x80\xda\xae\xe1\xfa\xd3\xfbw\x9d\x16X[w_0\x84\xd0\xba\x8bE\xad\xaa\x9a\xf9jl\xa0\xa8\xd2E\xbdX!\xd4\xd6\x070\xf4i\xad\xb4\x86Z< y\xb5\xb2&\x08e\"\x0cH\xdb\xce5\xc2\xc2\xba\xb5p\x12\xbc\x16\xbe\x86`\xef\xd1\x14\xe0\xb1\x11\x892\x04\xda8\x1ble5,\x9c]\xf1\ni\xd8\xb9\xfaJ\x84\xaa\xcea\xfae\xfae:=\xbc\xf8E\x8d\xd5=q\x91\x84+\xad\xd0\x04\xf8\xe7\x1d\xac\x85	\xb4\xa6<\xb0S\x83\x859Bh\xdd\xdcr\x05AY\xb2\xf8\xcd\x82\x05\x91\xea\x02d\xbc\x7f\xba\xc0P\xd5\x19aV\xc2T\xa85\xca\xa2\x8f5-'V\xa1d\x88\xf9\x867S\x85\x81:V\x97\x9e\n\x8c\xf9\x86 \xb9\xf8\xa4\x02{\xd1\xfa`W\xbc\x92\xef\xea-\xe0\x1bH\x0cB\xe9\xd3H#.i;t\x95\xca7\xa2\x03\xcc\x0f4\x1c\xf8\xe8w\xe4`\xda\x07tN\xc9\xce\xf7\x9d\xc1\"\xa6\xb3]\xc4\xba\x10\xb39\x15\x8er<\xc2\xb2q\xec\x9eT\xf0\xf3\x8e\xba\xe7F\xc2:\"\x93GIN\xb0\xb3\xcb\xf1h[\xa4\xf9\n\x9d\xc8\xe7F\x8a\x80\xac\xfd\xf3\xc7w0\x17\xe4\xa6T\xfck\xe5\x83u\x9b\xb2i}}G%?\x07\xd3j]\xa4\xbf\x1d\xce\xb6\xb6\x0b\xbf1\xd5\xb6\x1c\xed\xabL\x95\xfd\x9d\x15\x92\x15\x92\xd7\xbb\x8e\x01\x94\xc5\xdc8y\x8b\x12\"\xd2\xe1\xd3\xed\xe5\xedi\xacs\x06Qz@\xe7\xac\x8b1\xa5\xae\x0d7f\xc0\x17\xaew,\xc7\xc7\n\xb8!\xc6\x99{\xb8a\xfe\x11\xe9\x82S\xcb%:\x10\xa6#\x93\xa0\xdc\x88\xf21\xcfV\xc2\xdd{F\xe1*\xbd\xc6\xcc!,-\x87\xc9\x82G\x13\xedo\xa9\xcb\x13\"\xa5\x0dy\x99\xb8\xab\xb8\xaaS\x96n\xa9\xcaPk\x846:\xfbk\xf2o\xd7$\xbf\x96\x90\xabNr@pc\xc9\xf6%\xd4\xa2i\xd0\xf8IO`\x83\xebK[\xc1\x0c\xc4Z\xa8\x00\xcc\xd2\xde\xc9\xa3Q\x19j498\xea\xce\xae\x0c\xf8\x18\xf2\xc9p\xa3\x0e+M{qN)\xf9\xdf\x1bgWw\xc1)\xb3\x8c\xfb\x05d$8\xa5\xcf\xd9\xa4\xa7\xcbGl\xb4\xa8b\x8cj\x14\x92\xc3F_\xe6Vn\x98\xb0\xads\xe4Hi\xab\xd8@\xf6\x03]\x8eGR-\x16\xe7F\xde\xac\x884\x9f\x1c\x0e\x07\x07\x02-\xd2\x05\xf9\x0b\xa7\xdaS\x12\xa4\xb9\x97`3\x9e\xe2\xe4\x11\xa4d\xf2\x95\xc6U\x01\x8a7P\xc6oR-\xd1\x87\xc8\xdc\xf4y\xd6-~\xff\x9e>\xdd]\x9f\x93wbh:\xf9k\xe1k\xf4}\x88>8\xbbR\x9e\xe6\x02\x9d\xc3\x1feY\x0e\xf5\x94U\xad\xb4th\xfe,W\xa2\xc9{\xad\x83.O\xedH\xe2#y\x98\xabj\xf22\x0b\xd2\xb8\xc2\x19\xf0\xa8<\x97\xf1\xcb\xdb\xf7\x94\xf0\x98\xf6oXr\x06\xcf\xcf\x8e\x83iAv\x07\\\xf5\xc6ok\xbf\xc1G\x9aD\xb8\x01\x10 \x9d\xbdI\xa7nH\x84a{\xdc\xce.c%\xa6\xb4\x19\x0e$\x9dU\xf1\xd4oV\xe2OL\xeapP#\x17\x87t\xc3h\xdf\xd0\x9e\xceb\xbec\x17\x05\xab\xb1\x948o\x97;\xb1-m\x1b\xd0Qa\xd9\x8d\xf2\xb1\xf5\x14=\xf6\xff\xbaV\x1a!\x1f\xfa\xf2\xe5\x0e.o\\EK/lK\xe5~<\xfa6\x1e\x8d\x06\xf7\x04\x1a\xf6\x0f\x84\x1c\x9a?\x86\xb8\x7f\x9e\x8dG\xa3\x9d+\xc1l\xcf\xa6\x92\xfdt\xbb\xc8\x13\xb1\"W\xf2\xa1O;\xe2\xec\xf9b<\x1a\x8d\xb2[\x03Y1PY\x0c\x05\x0b\xc8b\xce&?S=\xc9\x8a]\x1f\x17\x0cS~1Yq@\x06\x92\xb7;\"\xc7\xfdC\xe7\xba\x13\xd9x4\x8a\xd6N\xa7p\xf2\x82\xd6\xb5\xc24'0\x8dR5\xdd\xa1@\xc1u\xc7[\xf0\xb5m\xb5\xa4AB\xa2\xc6\xc03D\xc7(\x1e\x8fh\x0cq-\x82ZD\xc8\x8eQk\xe1Ah\x87Bnz[H\x96\x9b\xfan\x08^\x1e\xb2\x9e\x8a)E8%}\xd4\xfdW\xc3D<\xc5\x83\xe6h?8\x90}\xc4\x7f\xb7\x18\xf37\xd8\x84\x98\x15\xbbqe?E\x13\x07\xeb\xfb\xc3\xc7\xd0\x9aX\xf7\xa3\xad\x07*/\xa3\xcb\x0e\xb5\xd0a\x1eR(\x81o\xdfn\x0d\x8a>\xa4ZQF\xc4\x01\xa3\x1d\xae\xec\x03^P\xb0\xf3cf\xa7\xb1GZ\x0e'\xf5r\xc2U\xa6r1\"\x9c\xf7[bv\"\x14'\xae\xda\xb8S\x11x\xd8\x9dc\xa4\x05\x0d\x80\xd6+*\xf0ew\xd3\xa0L\x8b|\x95\x1f\xe3c\xfe\x8e\x97g\x1e\xf4-7\xebl\xa5\xa7c\xa8\xd1\xad\x95\xc7\x82:w\xe2\x19Y\x1e=Q\xd2]\xd8\x08\x8d<r\x0f\xc8\x95\x10\x84\xb6\x06#S\xc93<\xb5\xdbmU\xb5\x06\xd9\xd8g\xcf\xb6\xb7\xee\x98\xd1\x1b\xff#e\xc6\xc0\x9amfl\xc9\xefh:543\x906z\xb3\xd1\x01\x9f\x92&B\x0c\x8ag\x1ckhL\x9c\xe3\xc2:\xa4\xd1#\x81	\xedy.\xef[\x81\xb1\xeb.\xa7x\x12\xbb\xb4\xd1\x02.\x14\x9a^r\x0e\xe9\xf5\x9c\xde\xfb\xac\x9f0\xe8u#\xb6\xe3\xdd\xc2\x89ej\xff\x84\xf5\x9b\x0dxJ\x8eMV\xb5\x0d\xf9\xe6\xebn\xe2\x9d\xc0\x8b\xaf0O/\xa5\x9d\x82 \"JE\x1e\xeez\x06}\x14a\x0f\xe3\xebOr\xfa\xd5\x139M}\xc5\xc0\xec\xa96\xed\xb5\xaa0?R\x00w\xb5\x1c\xcf\xf4s\xc9\x93y\"\x8b\x87\xa3\x95t\xb7\xe8\x82\xe9\xd2\xd2\x94\x0b\xeb\xae\x04\xcd{\xfc\xeb\xcb0\x03\x95\xf1\xe8\xc2k\x0e)\xffXA\xee\xa1\x14\xccc\x0d\x9c\x14\x87mbK\xb1\xc1K@\x19\xa9*\xa4\xf7\xeeh\xd0\x9d\x9e\xcd`?>\x07N|\x06/\x08\xf3\xc8\x98\xb0+\xca\xe7\x0eZ\x14do\x11\x9b\x81s\xb2\xfd\xfe\xf4\xc5t\xa3\x01\xf7\xc1\xbd^\xd6w\x9e~\xf70\xcagc\xbe\xf1A9 \xfa\xf4\x03+\xd5\x1bhj\xe1q\xfb\xbb\xc09\x0d\xe11\xb94.\x02\xbf\xddb\x9a\xf5?\x8c\xec+{\xb9\xdf\xbc5\x9ae\xa8\x89\xd8\xdd\x8c\xf0\xbf\xcc\xab\xff\x9e\xb8\x91	?!\xaa\xc3\x95P\xe6\xaf)\xdbq\xf4)\x8a\xf2KF\xa6&\xd1Q\xf0\x07\x8d\xeb\x07sz\x1a\xad\xf3\x98\xf4<\x82\xc7n;o\xa9\x19\xf4\xa3u\xfa\xed4\x0dB\xe3\xd1(\xbb\xbb>?y\x91Q\x07I?\xa1\x96\xf1\x7f\x84\xda\xce{\x14\x03\x8en|\x9as\xe3\xfe\xacL\xf8\xdb\xb9sb\x93w\x8a&\xa5C\xd9\x92\xdbrQ\xc0\xc3\x84\xee$\xe0\x19<\x94\xc1vO\xa6\x17\xffO\xd9\x95e\xf1\xed\xf1\x9f\x00\x00\x00\xff\xffPK\x07\x08\x9e\xf8\x86\x16\xc7\x08\x00\x00\x9e\x16\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xb4\x89|QSZ\xe7i\xc9\x00\x00\x00'\x01\x00\x00\x08\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x00\x00\x00\x00icon.svgUT\x05\x00\x01E\x85\xc2_PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xc3\x89|Q\xb8zn%\x03<\x00\x00\x1d\x8b\x00\x00\x0c\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x08\x01\x00\x00ios-icon.pngUT\x05\x00\x01^\x85\xc2_PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x1c\x80|Q\x9e\xf8\x86\x16\xc7\x08\x00\x00\x9e\x16\x00\x00\x0d\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81N=\x00\x00turbolinks.jsUT\x05\x00\x018t\xc2_PK\x05\x06\x00\x00\x00\x00\x03\x00\x03\x00\xc6\x00\x00\x00YF\x00\x00\x00\x00"
-		fs.Register(data)
-	}
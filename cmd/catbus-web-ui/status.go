@@ -0,0 +1,151 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"sync"
+	"time"
+)
+
+// syncQuietPeriod is how long after the last retained message we consider the initial burst done.
+const syncQuietPeriod = 500 * time.Millisecond
+
+// syncTimeout is the longest we wait for the initial burst after connecting, in case retained messages never stop.
+const syncTimeout = 10 * time.Second
+
+type (
+	// brokerStatus tracks the broker connection, and whether the retained messages that follow subscribing have all arrived.
+	brokerStatus struct {
+		// onSync is called once each time the initial state has arrived after (re)connecting, just before IsSynced becomes true.
+		// It is called with mu held, so it must not call back into brokerStatus.
+		onSync func()
+
+		mu          sync.Mutex
+		connected   bool
+		synced      bool
+		timer       *time.Timer
+		deadline    time.Time
+		since       time.Time
+		lastMessage time.Time
+		lastError   error
+	}
+
+	// statusDetails is a JSON-friendly snapshot of brokerStatus.
+	statusDetails struct {
+		Connected bool `json:"connected"`
+		Synced    bool `json:"synced"`
+
+		// Since is when the broker last connected or disconnected.
+		Since       *time.Time `json:"since,omitempty"`
+		LastMessage *time.Time `json:"lastMessage,omitempty"`
+		LastError   string     `json:"lastError,omitempty"`
+	}
+)
+
+func newBrokerStatus(onSync func()) *brokerStatus {
+	return &brokerStatus{onSync: onSync}
+}
+
+// Connected records that the broker connected and the subscription has been made.
+func (s *brokerStatus) Connected() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.connected = true
+	s.synced = false
+	s.since = time.Now()
+	s.deadline = s.since.Add(syncTimeout)
+	s.resetTimer()
+}
+
+// Disconnected records that the broker connection was lost.
+func (s *brokerStatus) Disconnected(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.connected = false
+	s.synced = false
+	s.since = time.Now()
+	s.lastError = err
+	if s.timer != nil {
+		s.timer.Stop()
+	}
+}
+
+// Message records that a message arrived from the broker.
+// Only retained messages are part of the initial burst, so devices that publish often don't hold it open.
+func (s *brokerStatus) Message(retained bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.lastMessage = time.Now()
+	if retained && s.connected && !s.synced {
+		s.resetTimer()
+	}
+}
+
+func (s *brokerStatus) IsConnected() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.connected
+}
+
+// IsSynced returns whether the broker is connected and its retained state has arrived.
+func (s *brokerStatus) IsSynced() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.connected && s.synced
+}
+
+func (s *brokerStatus) Details() statusDetails {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	d := statusDetails{
+		Connected: s.connected,
+		Synced:    s.connected && s.synced,
+	}
+	if !s.since.IsZero() {
+		since := s.since
+		d.Since = &since
+	}
+	if !s.lastMessage.IsZero() {
+		lastMessage := s.lastMessage
+		d.LastMessage = &lastMessage
+	}
+	if s.lastError != nil {
+		d.LastError = s.lastError.Error()
+	}
+	return d
+}
+
+// resetTimer must be called with mu held.
+func (s *brokerStatus) resetTimer() {
+	if s.timer != nil {
+		s.timer.Stop()
+	}
+	delay := syncQuietPeriod
+	if left := time.Until(s.deadline); left < delay {
+		delay = left
+	}
+	var timer *time.Timer
+	timer = time.AfterFunc(delay, func() {
+		// Holding mu throughout means a message arriving meanwhile finds us synced, rather than starting another timer.
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		// Superseded by a later message or reconnect?
+		if s.timer != timer || !s.connected || s.synced {
+			return
+		}
+
+		// Call onSync before reporting synced, so it can tidy up the state first.
+		if s.onSync != nil {
+			s.onSync()
+		}
+		s.synced = true
+	})
+	s.timer = timer
+}
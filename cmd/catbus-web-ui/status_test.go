@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// sendEvery calls status.Message every interval until stop is closed.
+func sendEvery(status *brokerStatus, interval time.Duration, retained bool, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			status.Message(retained)
+		case <-stop:
+			return
+		}
+	}
+}
+
+func TestSyncWithSteadyTraffic(t *testing.T) {
+	synced := make(chan struct{})
+	status := newBrokerStatus(func() { close(synced) })
+	status.Connected()
+
+	// A sensor publishing more often than the quiet period doesn't hold up the sync.
+	stop := make(chan struct{})
+	defer close(stop)
+	go sendEvery(status, syncQuietPeriod/3, false, stop)
+
+	select {
+	case <-synced:
+	case <-time.After(4 * syncQuietPeriod):
+		t.Fatal("never synced with steady non-retained messages")
+	}
+}
+
+func TestSyncWaitsForRetained(t *testing.T) {
+	synced := make(chan struct{})
+	status := newBrokerStatus(func() { close(synced) })
+	status.Connected()
+
+	stop := make(chan struct{})
+	go sendEvery(status, syncQuietPeriod/5, true, stop)
+
+	select {
+	case <-synced:
+		t.Fatal("synced while retained messages were still arriving")
+	case <-time.After(2 * syncQuietPeriod):
+	}
+	close(stop)
+
+	select {
+	case <-synced:
+	case <-time.After(4 * syncQuietPeriod):
+		t.Fatal("never synced after retained messages stopped")
+	}
+}
+
+func TestSyncOnce(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		syncs int
+	)
+	var status *brokerStatus
+	status = newBrokerStatus(func() {
+		mu.Lock()
+		syncs++
+		mu.Unlock()
+
+		// A straggling retained message arrives while the state is being tidied up.
+		go status.Message(true)
+		time.Sleep(syncQuietPeriod / 10)
+	})
+	status.Connected()
+
+	time.Sleep(4 * syncQuietPeriod)
+	mu.Lock()
+	defer mu.Unlock()
+	if syncs != 1 {
+		t.Errorf("got %v syncs, want 1", syncs)
+	}
+}
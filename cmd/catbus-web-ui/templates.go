@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"fmt"
+	"html/template"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"go.eth.moe/catbus-web-ui/config"
+)
+
+// templates are the page, and the controls on it.
+type templates struct {
+	indexTmpl  *template.Template
+	enumTmpl   *template.Template
+	rangeTmpl  *template.Template
+	toggleTmpl *template.Template
+	groupTmpl  *template.Template
+}
+
+// newTemplates returns the built-in templates, linking to assets from static.
+func newTemplates(static *assetServer) *templates {
+	t := &templates{}
+	funcs := map[string]interface{}{
+		"controlTmpl": t.controlTmpl,
+		"asset":       static.URL,
+	}
+	t.indexTmpl = template.Must(template.New("index.html").Funcs(funcs).Parse(indexSource))
+	t.enumTmpl = template.Must(template.New("enum").Parse(enumSource))
+	t.rangeTmpl = template.Must(template.New("range").Parse(rangeSource))
+	t.toggleTmpl = template.Must(template.New("toggle").Parse(toggleSource))
+	t.groupTmpl = template.Must(template.New("group").Parse(groupSource))
+	return t
+}
+
+// byFile returns the templates, by the file name that replaces them in the templatesDir.
+func (t *templates) byFile() map[string]**template.Template {
+	return map[string]**template.Template{
+		"index.html":  &t.indexTmpl,
+		"enum.html":   &t.enumTmpl,
+		"range.html":  &t.rangeTmpl,
+		"toggle.html": &t.toggleTmpl,
+		"group.html":  &t.groupTmpl,
+	}
+}
+
+// loadTemplates returns the built-in templates, with any replaced by those in dir, if it is set.
+// Replacements are parsed on top of the built-in templates, so index.html can still use "bulk" and "bulkBrightness", or redefine them.
+func loadTemplates(dir string, static *assetServer) (*templates, error) {
+	t := newTemplates(static)
+	if dir == "" {
+		return t, nil
+	}
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, &config.ValidationError{Problems: []config.Problem{templatesProblem("%v", err)}}
+	}
+
+	templateFiles := t.byFile()
+	var names []string
+	for name := range templateFiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var problems []config.Problem
+	for _, file := range files {
+		tmpl, ok := templateFiles[file.Name()]
+		if !ok {
+			problems = append(problems, templatesProblem("%q is not one of %v", file.Name(), strings.Join(names, ", ")))
+			continue
+		}
+
+		bytes, err := ioutil.ReadFile(filepath.Join(dir, file.Name()))
+		if err != nil {
+			problems = append(problems, templatesProblem("%v", err))
+			continue
+		}
+		t, err := (*tmpl).Clone()
+		if err != nil {
+			panic(err)
+		}
+		if t, err = t.Parse(string(bytes)); err != nil {
+			problems = append(problems, templatesProblem("%v", err))
+			continue
+		}
+		*tmpl = t
+	}
+	if len(problems) > 0 {
+		return nil, &config.ValidationError{Problems: problems}
+	}
+	return t, nil
+}
+
+func templatesProblem(format string, args ...interface{}) config.Problem {
+	return config.Problem{Path: "templatesDir", Message: fmt.Sprintf(format, args...)}
+}
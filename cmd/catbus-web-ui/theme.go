@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"net/http"
+)
+
+// themeCookie is the theme the user has chosen.
+const themeCookie = "catbus-web-ui-theme"
+
+type theme struct {
+	Name  string
+	Label string
+}
+
+// themes are the built-in themes, in the order they are offered.
+// The first is the default, and follows the browser's light or dark preference.
+var themes = []theme{
+	{"auto", "Automatic"},
+	{"light", "Light"},
+	{"dark", "Dark"},
+	{"high-contrast", "High contrast"},
+}
+
+// themeOf returns the name of the theme the user has chosen, or the default.
+func themeOf(r *http.Request) string {
+	if c, err := r.Cookie(themeCookie); err == nil && isTheme(c.Value) {
+		return c.Value
+	}
+	return themes[0].Name
+}
+
+// setTheme remembers the user's theme.
+// It must be called before the response is written.
+func setTheme(w http.ResponseWriter, name string) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     themeCookie,
+		Value:    name,
+		Path:     "/",
+		MaxAge:   10 * 365 * 24 * 60 * 60,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+func isTheme(name string) bool {
+	for _, t := range themes {
+		if t.Name == name {
+			return true
+		}
+	}
+	return false
+}
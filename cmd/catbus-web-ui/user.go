@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+)
+
+// userCookie identifies a browser, for per-user settings such as favorites.
+const userCookie = "catbus-web-ui-user"
+
+// userOf returns the user making a request, and gives them a new ID if they don't have one.
+// It must be called before the response is written.
+func userOf(w http.ResponseWriter, r *http.Request) string {
+	if c, err := r.Cookie(userCookie); err == nil && c.Value != "" {
+		return c.Value
+	}
+
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		panic(err)
+	}
+	user := hex.EncodeToString(bytes)
+	http.SetCookie(w, &http.Cookie{
+		Name:     userCookie,
+		Value:    user,
+		Path:     "/",
+		MaxAge:   10 * 365 * 24 * 60 * 60,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+	return user
+}
@@ -2,40 +2,523 @@
 //
 // SPDX-License-Identifier: MIT
 
+// Package config parses and validates catbus-web-ui's config file.
 package config
 
 import (
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"go.eth.moe/catbus-web-ui/home"
+	"gopkg.in/yaml.v3"
 )
 
 type (
 	Config struct {
 		BrokerURI string
+
+		// StaleAfter is how long a device can go without updates before it is shown as stale.
+		// Retained messages replayed by the broker on reconnecting aren't updates, so a device is counted from when it was first seen.
+		// Zero means devices are never stale.
+		StaleAfter time.Duration
+
+		Layout home.Layout
+
+		// FavoritesPath is where users' favorites are stored.
+		// If it is empty, favorites are forgotten on restart.
+		FavoritesPath string
+
+		Groups []home.GroupConfig
+
+		// AssetsDir is a directory of static files that replace or add to the built-in ones, such as icon.svg.
+		AssetsDir string
+
+		// TemplatesDir is a directory of templates that replace the built-in ones with the same file name, such as index.html.
+		TemplatesDir string
 	}
 
 	config struct {
-		MQTTBroker string `json:"mqttBroker"`
+		MQTTBroker    string          `json:"mqttBroker"`
+		StaleAfter    string          `json:"staleAfter"`
+		Layout        json.RawMessage `json:"layout"`
+		FavoritesPath string          `json:"favoritesPath"`
+		Groups        json.RawMessage `json:"groups"`
+		AssetsDir     string          `json:"assetsDir"`
+		TemplatesDir  string          `json:"templatesDir"`
+	}
+
+	group struct {
+		Name    string   `json:"name"`
+		Devices []string `json:"devices"`
+	}
+
+	layout struct {
+		Zones []json.RawMessage `json:"zones"`
+	}
+	zoneLayout struct {
+		Name    string            `json:"name"`
+		Alias   string            `json:"alias"`
+		Icon    string            `json:"icon"`
+		Hidden  bool              `json:"hidden"`
+		Pinned  bool              `json:"pinned"`
+		Devices []json.RawMessage `json:"devices"`
+	}
+	deviceLayout struct {
+		Name   string `json:"name"`
+		Alias  string `json:"alias"`
+		Icon   string `json:"icon"`
+		Hidden bool   `json:"hidden"`
+		Pinned bool   `json:"pinned"`
+	}
+
+	// LookupEnvFunc looks up an environment variable, like os.LookupEnv.
+	LookupEnvFunc func(string) (string, bool)
+
+	// ValidationError lists every problem found in a config.
+	ValidationError struct {
+		Problems []Problem
+	}
+
+	// Problem is a single problem with a config, at a JSON path such as "mqttBroker".
+	Problem struct {
+		Path    string
+		Message string
 	}
 )
 
-func ParseFile(path string) (*Config, error) {
+var (
+	// knownFields are the keys of each raw config struct.
+	knownFields = map[string]bool{
+		"mqttBroker":    true,
+		"staleAfter":    true,
+		"layout":        true,
+		"favoritesPath": true,
+		"groups":        true,
+		"assetsDir":     true,
+		"templatesDir":  true,
+	}
+	knownGroupFields = map[string]bool{
+		"name":    true,
+		"devices": true,
+	}
+	knownLayoutFields = map[string]bool{
+		"zones": true,
+	}
+	knownZoneLayoutFields = map[string]bool{
+		"name":    true,
+		"alias":   true,
+		"icon":    true,
+		"hidden":  true,
+		"pinned":  true,
+		"devices": true,
+	}
+	knownDeviceLayoutFields = map[string]bool{
+		"name":   true,
+		"alias":  true,
+		"icon":   true,
+		"hidden": true,
+		"pinned": true,
+	}
+
+	// envFields are the environment variables that override config fields.
+	// String fields take the variable's value as-is, other fields take JSON.
+	envFields = []struct {
+		env    string
+		key    string
+		string bool
+	}{
+		{"CATBUS_WEB_UI_BROKER_URI", "mqttBroker", true},
+		{"CATBUS_WEB_UI_STALE_AFTER", "staleAfter", true},
+		{"CATBUS_WEB_UI_LAYOUT", "layout", false},
+		{"CATBUS_WEB_UI_FAVORITES_PATH", "favoritesPath", true},
+		{"CATBUS_WEB_UI_GROUPS", "groups", false},
+		{"CATBUS_WEB_UI_ASSETS_DIR", "assetsDir", true},
+		{"CATBUS_WEB_UI_TEMPLATES_DIR", "templatesDir", true},
+	}
+
+	// brokerSchemes are the URI schemes that the MQTT client can dial.
+	brokerSchemes = []string{"mqtt", "mqtts", "ssl", "tcp", "tls", "ws", "wss"}
+)
+
+// Load reads the config file at path, if path is set, and then applies overrides from CATBUS_WEB_UI_* environment variables.
+// The file may be JSON, YAML, or TOML, chosen by its extension.
+// Every problem found is reported together in a *ValidationError.
+func Load(path string, lookupEnv LookupEnvFunc) (*Config, error) {
+	fields := map[string]json.RawMessage{}
+	if path != "" {
+		var err error
+		fields, err = readFields(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	fromEnv := map[string]string{}
+	for _, f := range envFields {
+		value, ok := lookupEnv(f.env)
+		if !ok {
+			continue
+		}
+		if f.string {
+			fields[f.key], _ = json.Marshal(value)
+		} else {
+			fields[f.key] = json.RawMessage(value)
+		}
+		fromEnv[f.key] = f.env
+	}
+
+	c, err := parseFields(fields)
+	if err, ok := err.(*ValidationError); ok {
+		for i, p := range err.Problems {
+			if env, ok := fromEnv[fieldOf(p.Path)]; ok {
+				err.Problems[i].Message = fmt.Sprintf("%v (from $%v)", p.Message, env)
+			}
+		}
+	}
+	return c, err
+}
+
+// readFields reads a config file into its top-level JSON fields.
+func readFields(path string) (map[string]json.RawMessage, error) {
 	bytes, err := ioutil.ReadFile(path)
 	if err != nil {
 		return nil, err
 	}
 
-	raw := config{}
-	if err := json.Unmarshal(bytes, &raw); err != nil {
+	switch ext := filepath.Ext(path); ext {
+	case ".json":
+	case ".yaml", ".yml":
+		var doc map[string]interface{}
+		if err := yaml.Unmarshal(bytes, &doc); err != nil {
+			return nil, err
+		}
+		if bytes, err = json.Marshal(doc); err != nil {
+			return nil, fmt.Errorf("could not convert YAML to JSON: %w", err)
+		}
+	case ".toml":
+		var doc map[string]interface{}
+		if err := toml.Unmarshal(bytes, &doc); err != nil {
+			return nil, err
+		}
+		if bytes, err = json.Marshal(doc); err != nil {
+			return nil, fmt.Errorf("could not convert TOML to JSON: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unknown config format %q, want .json, .yaml, .yml, or .toml", ext)
+	}
+
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(bytes, &fields); err != nil {
 		return nil, err
 	}
+	if fields == nil {
+		// An empty YAML document.
+		fields = map[string]json.RawMessage{}
+	}
+	return fields, nil
+}
+
+func parseFields(fields map[string]json.RawMessage) (*Config, error) {
+	raw := config{}
+	problems := decodeObject("", fields, knownFields, &raw)
+	for _, p := range validate(raw) {
+		// A field that failed to decode has already been reported.
+		if hasPath(problems, p.Path) {
+			continue
+		}
+		problems = append(problems, p)
+	}
+
+	var l home.Layout
+	if raw.Layout != nil && !hasPath(problems, "layout") {
+		var layoutProblems []Problem
+		l, layoutProblems = parseLayout("layout", raw.Layout)
+		problems = append(problems, layoutProblems...)
+	}
+
+	var groups []home.GroupConfig
+	if raw.Groups != nil && !hasPath(problems, "groups") {
+		var groupProblems []Problem
+		groups, groupProblems = parseGroups("groups", raw.Groups)
+		problems = append(problems, groupProblems...)
+	}
 
-	return configFromConfig(raw), nil
+	if len(problems) > 0 {
+		return nil, &ValidationError{problems}
+	}
+	c := configFromConfig(raw)
+	c.Layout = l
+	c.Groups = groups
+	return c, nil
 }
 
 func configFromConfig(raw config) *Config {
 	c := &Config{
-		BrokerURI:    raw.MQTTBroker,
+		BrokerURI:     raw.MQTTBroker,
+		FavoritesPath: raw.FavoritesPath,
+		AssetsDir:     raw.AssetsDir,
+		TemplatesDir:  raw.TemplatesDir,
+	}
+	if raw.StaleAfter != "" {
+		c.StaleAfter, _ = time.ParseDuration(raw.StaleAfter)
 	}
 	return c
 }
+
+func validate(raw config) []Problem {
+	var problems []Problem
+	if err := validateBrokerURI(raw.MQTTBroker); err != nil {
+		problems = append(problems, Problem{"mqttBroker", err.Error()})
+	}
+	if raw.StaleAfter != "" {
+		if d, err := time.ParseDuration(raw.StaleAfter); err != nil || d < 0 {
+			problems = append(problems, Problem{"staleAfter", fmt.Sprintf("must be a duration such as \"1h\", or \"0s\" for never, got %q", raw.StaleAfter)})
+		}
+	}
+	for _, dir := range []struct{ path, dir string }{
+		{"assetsDir", raw.AssetsDir},
+		{"templatesDir", raw.TemplatesDir},
+	} {
+		if dir.dir == "" {
+			continue
+		}
+		if info, err := os.Stat(dir.dir); err != nil || !info.IsDir() {
+			problems = append(problems, Problem{dir.path, fmt.Sprintf("%q must be a directory", dir.dir)})
+		}
+	}
+	return problems
+}
+
+func parseLayout(path string, data json.RawMessage) (home.Layout, []Problem) {
+	var l home.Layout
+	raw := layout{}
+	problems := decodeObjectBytes(path, data, knownLayoutFields, &raw)
+
+	seenZones := map[string]bool{}
+	for i, data := range raw.Zones {
+		zonePath := fmt.Sprintf("%v.zones[%d]", path, i)
+		rawZone := zoneLayout{}
+		problems = append(problems, decodeObjectBytes(zonePath, data, knownZoneLayoutFields, &rawZone)...)
+		switch {
+		case hasPath(problems, zonePath) || hasPath(problems, zonePath+".name"):
+		case rawZone.Name == "":
+			problems = append(problems, Problem{zonePath + ".name", "must be set"})
+		case seenZones[rawZone.Name]:
+			problems = append(problems, Problem{zonePath + ".name", fmt.Sprintf("zone %q is already in the layout", rawZone.Name)})
+		}
+		seenZones[rawZone.Name] = true
+
+		zl := home.ZoneLayout{
+			Name:   rawZone.Name,
+			Alias:  rawZone.Alias,
+			Icon:   rawZone.Icon,
+			Hidden: rawZone.Hidden,
+			Pinned: rawZone.Pinned,
+		}
+		seenDevices := map[string]bool{}
+		for j, data := range rawZone.Devices {
+			devicePath := fmt.Sprintf("%v.devices[%d]", zonePath, j)
+			rawDevice := deviceLayout{}
+			problems = append(problems, decodeObjectBytes(devicePath, data, knownDeviceLayoutFields, &rawDevice)...)
+			switch {
+			case hasPath(problems, devicePath) || hasPath(problems, devicePath+".name"):
+			case rawDevice.Name == "":
+				problems = append(problems, Problem{devicePath + ".name", "must be set"})
+			case seenDevices[rawDevice.Name]:
+				problems = append(problems, Problem{devicePath + ".name", fmt.Sprintf("device %q is already in the layout", rawDevice.Name)})
+			}
+			seenDevices[rawDevice.Name] = true
+
+			zl.Devices = append(zl.Devices, home.DeviceLayout{
+				Name:   rawDevice.Name,
+				Alias:  rawDevice.Alias,
+				Icon:   rawDevice.Icon,
+				Hidden: rawDevice.Hidden,
+				Pinned: rawDevice.Pinned,
+			})
+		}
+		l.Zones = append(l.Zones, zl)
+	}
+	return l, problems
+}
+
+func parseGroups(path string, data json.RawMessage) ([]home.GroupConfig, []Problem) {
+	var rawGroups []json.RawMessage
+	if err := json.Unmarshal(data, &rawGroups); err != nil {
+		return nil, []Problem{{path, "must be a list"}}
+	}
+
+	var groups []home.GroupConfig
+	var problems []Problem
+	seen := map[string]bool{}
+	for i, data := range rawGroups {
+		groupPath := fmt.Sprintf("%v[%d]", path, i)
+		raw := group{}
+		problems = append(problems, decodeObjectBytes(groupPath, data, knownGroupFields, &raw)...)
+
+		switch {
+		case hasPath(problems, groupPath) || hasPath(problems, groupPath+".name"):
+		case raw.Name == "":
+			problems = append(problems, Problem{groupPath + ".name", "must be set"})
+		case strings.Contains(raw.Name, "/"):
+			problems = append(problems, Problem{groupPath + ".name", fmt.Sprintf("must not contain '/', got %q", raw.Name)})
+		case seen[raw.Name]:
+			problems = append(problems, Problem{groupPath + ".name", fmt.Sprintf("group %q is already defined", raw.Name)})
+		}
+		seen[raw.Name] = true
+
+		for j, device := range raw.Devices {
+			parts := strings.Split(device, "/")
+			if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+				problems = append(problems, Problem{fmt.Sprintf("%v.devices[%d]", groupPath, j), fmt.Sprintf("must be of the form zone/device, got %q", device)})
+			}
+		}
+
+		groups = append(groups, home.GroupConfig{
+			Name:    raw.Name,
+			Devices: raw.Devices,
+		})
+	}
+	return groups, problems
+}
+
+// decodeObjectBytes decodes a JSON object into v like decodeObject.
+func decodeObjectBytes(path string, data json.RawMessage, known map[string]bool, v interface{}) []Problem {
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return []Problem{{path, "must be an object"}}
+	}
+	return decodeObject(path, fields, known, v)
+}
+
+// decodeObject decodes the fields of a JSON object into v, a pointer to a struct.
+// It reports unknown fields, and decodes each field separately so one bad field doesn't hide the rest.
+func decodeObject(path string, fields map[string]json.RawMessage, known map[string]bool, v interface{}) []Problem {
+	var problems []Problem
+	for _, key := range sortedKeys(fields) {
+		fieldPath := joinPath(path, key)
+		if !known[key] {
+			problems = append(problems, Problem{fieldPath, "unknown field"})
+			continue
+		}
+		field, err := json.Marshal(map[string]json.RawMessage{key: fields[key]})
+		if err != nil {
+			problems = append(problems, Problem{fieldPath, "invalid JSON"})
+			continue
+		}
+		if err := json.Unmarshal(field, v); err != nil {
+			problems = append(problems, Problem{fieldPath, typeErrorMessage(err)})
+		}
+	}
+	return problems
+}
+
+func validateBrokerURI(uri string) error {
+	if uri == "" {
+		return fmt.Errorf("must be set")
+	}
+	u, err := url.Parse(uri)
+	if err != nil {
+		return fmt.Errorf("invalid URI %q", uri)
+	}
+	if !contains(brokerSchemes, u.Scheme) {
+		return fmt.Errorf("scheme of %q must be one of %v", uri, strings.Join(brokerSchemes, ", "))
+	}
+	host, port, err := net.SplitHostPort(u.Host)
+	if err != nil || host == "" {
+		return fmt.Errorf("%q must have a host and port", uri)
+	}
+	if n, err := strconv.Atoi(port); err != nil || n < 1 || n > 65535 {
+		return fmt.Errorf("invalid port %q in %q", port, uri)
+	}
+	return nil
+}
+
+func (e *ValidationError) Error() string {
+	var msgs []string
+	for _, p := range e.Problems {
+		msgs = append(msgs, p.String())
+	}
+	return fmt.Sprintf("invalid config: %v", strings.Join(msgs, "; "))
+}
+
+func (p Problem) String() string {
+	return fmt.Sprintf("%v: %v", p.Path, p.Message)
+}
+
+func typeErrorMessage(err error) string {
+	err2, ok := err.(*json.UnmarshalTypeError)
+	if !ok {
+		return err.Error()
+	}
+	want := err2.Type.String()
+	switch err2.Type.Kind() {
+	case reflect.Bool:
+		want = "a boolean"
+	case reflect.Int, reflect.Int64, reflect.Float64:
+		want = "a number"
+	case reflect.String:
+		want = "a string"
+	case reflect.Map, reflect.Struct:
+		want = "an object"
+	case reflect.Slice:
+		want = "a list"
+		if err2.Type == reflect.TypeOf(json.RawMessage{}) {
+			want = "an object"
+		}
+	}
+	return fmt.Sprintf("must be %v, got %v", want, err2.Value)
+}
+
+func sortedKeys(m map[string]json.RawMessage) []string {
+	var keys []string
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// fieldOf returns the top-level field of path, e.g. "layout" for "layout.zones[0].name".
+func fieldOf(path string) string {
+	if i := strings.IndexAny(path, ".["); i >= 0 {
+		return path[:i]
+	}
+	return path
+}
+
+func hasPath(problems []Problem, path string) bool {
+	for _, p := range problems {
+		if p.Path == path {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(haystack []string, needle string) bool {
+	for _, s := range haystack {
+		if s == needle {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,263 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package config
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"go.eth.moe/catbus-web-ui/home"
+)
+
+// writeConfig writes contents to a temporary file called name, and returns its path.
+func writeConfig(t *testing.T, name, contents string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	if err := ioutil.WriteFile(path, []byte(contents), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func envOf(env map[string]string) LookupEnvFunc {
+	return func(key string) (string, bool) {
+		value, ok := env[key]
+		return value, ok
+	}
+}
+
+func TestLoadProblems(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		env  map[string]string
+		want []Problem
+	}{
+		{
+			name: "valid",
+			json: `{"mqttBroker": "tcp://localhost:1883", "staleAfter": "0s"}`,
+		},
+		{
+			name: "unknown fields",
+			json: `{"mqttBroker": "tcp://localhost:1883", "mqtt_broker": "tcp://localhost:1883", "colour": "red"}`,
+			want: []Problem{
+				{"colour", "unknown field"},
+				{"mqtt_broker", "unknown field"},
+			},
+		},
+		{
+			name: "broker",
+			json: `{"mqttBroker": "http://localhost"}`,
+			want: []Problem{
+				{"mqttBroker", `scheme of "http://localhost" must be one of mqtt, mqtts, ssl, tcp, tls, ws, wss`},
+			},
+		},
+		{
+			name: "type errors",
+			json: `{"mqttBroker": 1883, "favoritesPath": true, "staleAfter": "-1h"}`,
+			want: []Problem{
+				{"favoritesPath", "must be a string, got bool"},
+				{"mqttBroker", "must be a string, got number"},
+				{"staleAfter", `must be a duration such as "1h", or "0s" for never, got "-1h"`},
+			},
+		},
+		{
+			name: "layout",
+			json: `{
+				"mqttBroker": "tcp://localhost:1883",
+				"layout": {"zones": [
+					{"name": "bedroom", "colour": "red", "devices": [
+						{"name": "lamp"},
+						{"name": "lamp", "hidden": "yes"},
+						{"alias": "Radio"}
+					]},
+					{"name": "bedroom"},
+					"kitchen"
+				]}
+			}`,
+			want: []Problem{
+				{"layout.zones[0].colour", "unknown field"},
+				{"layout.zones[0].devices[1].hidden", "must be a boolean, got string"},
+				{"layout.zones[0].devices[1].name", `device "lamp" is already in the layout`},
+				{"layout.zones[0].devices[2].name", "must be set"},
+				{"layout.zones[1].name", `zone "bedroom" is already in the layout`},
+				{"layout.zones[2]", "must be an object"},
+			},
+		},
+		{
+			name: "groups",
+			json: `{
+				"mqttBroker": "tcp://localhost:1883",
+				"groups": [
+					{"name": "up/stairs", "devices": ["bedroom/lamp", "bedroom", "bedroom/lamp/power"]},
+					{"name": "lights", "devices": "bedroom/lamp"},
+					{"name": "lights"},
+					{},
+					"lights"
+				]
+			}`,
+			want: []Problem{
+				{"groups[0].devices[1]", `must be of the form zone/device, got "bedroom"`},
+				{"groups[0].devices[2]", `must be of the form zone/device, got "bedroom/lamp/power"`},
+				{"groups[0].name", `must not contain '/', got "up/stairs"`},
+				{"groups[1].devices", "must be a list, got string"},
+				{"groups[2].name", `group "lights" is already defined`},
+				{"groups[3].name", "must be set"},
+				{"groups[4]", "must be an object"},
+			},
+		},
+		{
+			name: "env",
+			json: `{"mqttBroker": "tcp://localhost:1883"}`,
+			env: map[string]string{
+				"CATBUS_WEB_UI_STALE_AFTER": "soon",
+				"CATBUS_WEB_UI_LAYOUT":      `{"zones": [{"name": 3}]}`,
+				"CATBUS_WEB_UI_GROUPS":      `{`,
+			},
+			want: []Problem{
+				{"groups", "invalid JSON (from $CATBUS_WEB_UI_GROUPS)"},
+				{"layout.zones[0].name", "must be a string, got number (from $CATBUS_WEB_UI_LAYOUT)"},
+				{"staleAfter", `must be a duration such as "1h", or "0s" for never, got "soon" (from $CATBUS_WEB_UI_STALE_AFTER)`},
+			},
+		},
+		{
+			name: "env without a file",
+			env:  map[string]string{"CATBUS_WEB_UI_BROKER_URI": ""},
+			want: []Problem{
+				{"mqttBroker", "must be set (from $CATBUS_WEB_UI_BROKER_URI)"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := ""
+			if tt.json != "" {
+				path = writeConfig(t, "config.json", tt.json)
+			}
+
+			_, err := Load(path, envOf(tt.env))
+			var got []Problem
+			if err != nil {
+				verr, ok := err.(*ValidationError)
+				if !ok {
+					t.Fatalf("got error %v, want a *ValidationError", err)
+				}
+				got = verr.Problems
+			}
+			// Problems are sorted within each part of the config, but not across them.
+			sort.Slice(got, func(i, j int) bool {
+				return got[i].Path < got[j].Path
+			})
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got problems %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadFormats(t *testing.T) {
+	want := &Config{
+		BrokerURI:  "tcp://localhost:1883",
+		StaleAfter: time.Hour,
+		Layout: home.Layout{Zones: []home.ZoneLayout{{
+			Name:    "bedroom",
+			Pinned:  true,
+			Devices: []home.DeviceLayout{{Name: "lamp", Alias: "Reading lamp"}},
+		}}},
+		Groups: []home.GroupConfig{{Name: "lights", Devices: []string{"bedroom/lamp"}}},
+	}
+
+	tests := []struct {
+		name     string
+		contents string
+	}{
+		{
+			name: "config.json",
+			contents: `{
+				"mqttBroker": "tcp://localhost:1883",
+				"staleAfter": "1h",
+				"layout": {"zones": [{"name": "bedroom", "pinned": true, "devices": [{"name": "lamp", "alias": "Reading lamp"}]}]},
+				"groups": [{"name": "lights", "devices": ["bedroom/lamp"]}]
+			}`,
+		},
+		{
+			name: "config.yaml",
+			contents: `
+mqttBroker: tcp://localhost:1883
+staleAfter: 1h
+layout:
+  zones:
+    - name: bedroom
+      pinned: true
+      devices:
+        - name: lamp
+          alias: Reading lamp
+groups:
+  - name: lights
+    devices: [bedroom/lamp]
+`,
+		},
+		{
+			name: "config.toml",
+			contents: `
+mqttBroker = "tcp://localhost:1883"
+staleAfter = "1h"
+
+[[layout.zones]]
+name = "bedroom"
+pinned = true
+
+[[layout.zones.devices]]
+name = "lamp"
+alias = "Reading lamp"
+
+[[groups]]
+name = "lights"
+devices = ["bedroom/lamp"]
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Load(writeConfig(t, tt.name, tt.contents), envOf(nil))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("got %+v, want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestLoadEnvOverridesFile(t *testing.T) {
+	path := writeConfig(t, "config.yaml", "mqttBroker: tcp://localhost:1883\nfavoritesPath: /var/lib/favorites.json\n")
+	got, err := Load(path, envOf(map[string]string{
+		"CATBUS_WEB_UI_BROKER_URI": "tcp://broker:1883",
+		"CATBUS_WEB_UI_GROUPS":     `[{"name": "lights", "devices": ["bedroom/lamp"]}]`,
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &Config{
+		BrokerURI:     "tcp://broker:1883",
+		FavoritesPath: "/var/lib/favorites.json",
+		Groups:        []home.GroupConfig{{Name: "lights", Devices: []string{"bedroom/lamp"}}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestLoadUnknownFormat(t *testing.T) {
+	if _, err := Load(writeConfig(t, "config.ini", "mqttBroker=tcp://localhost:1883"), envOf(nil)); err == nil {
+		t.Error("loaded a .ini config, want an error")
+	}
+}
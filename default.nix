@@ -10,8 +10,10 @@ stdenv.mkDerivation rec {
   version = "latest"; 
 
   src = lib.sourceFilesBySuffices ./. [
+    ".css"
     ".html"
     ".js"
+    ".json"
     ".png"
     ".svg"
   ];
@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+// Package favorites stores each user's favorite controls, by topic.
+package favorites
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+type Store struct {
+	path string
+
+	mu           sync.Mutex
+	topicsByUser map[string][]string
+}
+
+// Open opens the store at path, creating it on the first write if it doesn't exist.
+// If path is empty, favorites are kept in memory only.
+func Open(path string) (*Store, error) {
+	s := &Store{
+		path:         path,
+		topicsByUser: map[string][]string{},
+	}
+	if path == "" {
+		return s, nil
+	}
+
+	bytes, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(bytes, &s.topicsByUser); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// List returns the user's favorite topics, in the order they were added.
+func (s *Store) List(user string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	topics := make([]string, len(s.topicsByUser[user]))
+	copy(topics, s.topicsByUser[user])
+	return topics
+}
+
+// Add adds a topic to the user's favorites, if it isn't already.
+func (s *Store) Add(user, topic string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, t := range s.topicsByUser[user] {
+		if t == topic {
+			return nil
+		}
+	}
+	topics := make([]string, 0, len(s.topicsByUser[user])+1)
+	topics = append(topics, s.topicsByUser[user]...)
+	return s.update(user, append(topics, topic))
+}
+
+// Remove removes a topic from the user's favorites, if it is there.
+func (s *Store) Remove(user, topic string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var topics []string
+	for _, t := range s.topicsByUser[user] {
+		if t != topic {
+			topics = append(topics, t)
+		}
+	}
+	if len(topics) == len(s.topicsByUser[user]) {
+		return nil
+	}
+	return s.update(user, topics)
+}
+
+// update sets the user's favorites, once they have been saved, so a failed save changes nothing.
+// It must be called with mu held.
+func (s *Store) update(user string, topics []string) error {
+	topicsByUser := make(map[string][]string, len(s.topicsByUser)+1)
+	for u, t := range s.topicsByUser {
+		topicsByUser[u] = t
+	}
+	if len(topics) == 0 {
+		delete(topicsByUser, user)
+	} else {
+		topicsByUser[user] = topics
+	}
+
+	if err := s.save(topicsByUser); err != nil {
+		return err
+	}
+	s.topicsByUser = topicsByUser
+	return nil
+}
+
+// save writes topicsByUser to disk atomically.
+func (s *Store) save(topicsByUser map[string][]string) error {
+	if s.path == "" {
+		return nil
+	}
+
+	bytes, err := json.MarshalIndent(topicsByUser, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	f, err := ioutil.TempFile(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(bytes); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), s.path)
+}
@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package favorites
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestFailedSaveChangesNothing(t *testing.T) {
+	dir := t.TempDir()
+	s, err := Open(filepath.Join(dir, "favorites.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Add("user", "home/bedroom/lamp/power"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Without the directory, nothing can be saved.
+	if err := os.RemoveAll(dir); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"home/bedroom/lamp/power"}
+
+	if err := s.Add("user", "home/kitchen/radio/power"); err == nil {
+		t.Error("could add a favorite without saving it")
+	}
+	if got := s.List("user"); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v after failing to add, want %v", got, want)
+	}
+
+	if err := s.Remove("user", "home/bedroom/lamp/power"); err == nil {
+		t.Error("could remove a favorite without saving it")
+	}
+	if got := s.List("user"); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v after failing to remove, want %v", got, want)
+	}
+}
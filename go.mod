@@ -4,11 +4,13 @@
 
 module go.eth.moe/catbus-web-ui
 
-go 1.15
+go 1.16
 
 require (
+	github.com/BurntSushi/toml v0.4.1
+	github.com/andybalholm/brotli v1.0.4
 	github.com/gorilla/mux v1.8.0
-	github.com/rakyll/statik v0.1.7
 	go.eth.moe/catbus v0.0.6
 	golang.org/x/net v0.0.0-20201110031124-69a78807bb2b // indirect
+	gopkg.in/yaml.v3 v3.0.1
 )
@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package home
+
+import (
+	"strings"
+)
+
+// Filter returns the parts of h whose names match query, case-insensitively.
+// A matching zone keeps all of its devices, and a matching device keeps all of its controls.
+func (h Home) Filter(query string) Home {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" {
+		return h
+	}
+	matches := func(names ...string) bool {
+		for _, name := range names {
+			if strings.Contains(strings.ToLower(name), query) {
+				return true
+			}
+		}
+		return false
+	}
+
+	filtered := Home{
+		zonesByName:  map[string]Zone{},
+		groupsByName: map[string]*Group{},
+	}
+	for name, zone := range h.zonesByName {
+		if matches(zone.name, zone.DisplayName()) {
+			filtered.zonesByName[name] = zone
+			continue
+		}
+
+		devicesByName := map[string]Device{}
+		for name, device := range zone.devicesByName {
+			if matches(device.name, device.DisplayName()) {
+				devicesByName[name] = device
+				continue
+			}
+
+			controlsByName := map[string]Control{}
+			for name, control := range device.controlsByName {
+				if matches(control.Name()) {
+					controlsByName[name] = control
+				}
+			}
+			if len(controlsByName) > 0 {
+				device.controlsByName = controlsByName
+				devicesByName[name] = device
+			}
+		}
+		if len(devicesByName) > 0 {
+			zone.devicesByName = devicesByName
+			filtered.zonesByName[name] = zone
+		}
+	}
+	for name, group := range h.groupsByName {
+		if matches(group.name, group.DisplayName()) {
+			filtered.groupsByName[name] = group
+		}
+	}
+	return filtered
+}
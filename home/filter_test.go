@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package home
+
+import (
+	"reflect"
+	"testing"
+)
+
+// visibleTopics returns the topics of every control that h shows, and the names of its groups.
+func visibleTopics(h Home) (topics []string, groups []string) {
+	for _, zone := range h.Zones() {
+		for _, device := range zone.Devices() {
+			for _, control := range device.Controls() {
+				topics = append(topics, control.Topic())
+			}
+		}
+	}
+	for _, group := range h.Groups() {
+		groups = append(groups, group.Name())
+	}
+	return topics, groups
+}
+
+func TestFilter(t *testing.T) {
+	h := OfValuesByTopic(map[string]string{
+		"home/bedroom/lamp/power":              "on",
+		"home/bedroom/lamp/brightness_percent": "40",
+		"home/kitchen/radio/power":             "off",
+		"home/kitchen/radio/input_enum":        "fm",
+		"home/kitchen/radio/input_enum/values": "aux\nfm",
+		"home/living-room/tv/power":            "on",
+	}).WithLayout(Layout{Zones: []ZoneLayout{
+		{Name: "living-room", Devices: []DeviceLayout{{Name: "tv", Alias: "Telly"}}},
+	}}).WithGroups([]GroupConfig{
+		{Name: "downstairs", Devices: []string{"kitchen/radio", "living-room/tv"}},
+	})
+
+	tests := []struct {
+		query      string
+		wantTopics []string
+		wantGroups []string
+	}{
+		{
+			query: "",
+			wantTopics: []string{
+				"home/living-room/tv/power",
+				"home/bedroom/lamp/brightness_percent",
+				"home/bedroom/lamp/power",
+				"home/kitchen/radio/input_enum",
+				"home/kitchen/radio/power",
+			},
+			wantGroups: []string{"downstairs"},
+		},
+		{
+			// A matching zone keeps all of its devices.
+			query:      "BED",
+			wantTopics: []string{"home/bedroom/lamp/brightness_percent", "home/bedroom/lamp/power"},
+		},
+		{
+			// A matching device keeps all of its controls.
+			query:      "  lamp ",
+			wantTopics: []string{"home/bedroom/lamp/brightness_percent", "home/bedroom/lamp/power"},
+		},
+		{
+			query:      "bright",
+			wantTopics: []string{"home/bedroom/lamp/brightness_percent"},
+		},
+		{
+			query:      "input",
+			wantTopics: []string{"home/kitchen/radio/input_enum"},
+		},
+		{
+			// Display names match as well as names.
+			query:      "living room",
+			wantTopics: []string{"home/living-room/tv/power"},
+		},
+		{
+			query:      "telly",
+			wantTopics: []string{"home/living-room/tv/power"},
+		},
+		{
+			query:      "downstairs",
+			wantGroups: []string{"downstairs"},
+		},
+		{
+			query: "garage",
+		},
+	}
+	for _, tt := range tests {
+		topics, groups := visibleTopics(h.Filter(tt.query))
+		if !reflect.DeepEqual(topics, tt.wantTopics) {
+			t.Errorf("got topics %v for %q, want %v", topics, tt.query, tt.wantTopics)
+		}
+		if !reflect.DeepEqual(groups, tt.wantGroups) {
+			t.Errorf("got groups %v for %q, want %v", groups, tt.query, tt.wantGroups)
+		}
+	}
+}
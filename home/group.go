@@ -0,0 +1,167 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package home
+
+import (
+	"sort"
+	"strings"
+)
+
+// GroupsZone is the pseudo-zone that group topics live under, e.g. home/_groups/downstairs-lights/power.
+const GroupsZone = "_groups"
+
+type (
+	// GroupConfig defines a group of devices, by "zone/device" path.
+	GroupConfig struct {
+		Name    string
+		Devices []string
+	}
+
+	// Group is a Toggle for the power of several devices at once.
+	// Devices join groups either from a GroupConfig, or by listing group names on their home/{zone}/{device}/groups topic.
+	Group struct {
+		name    string
+		members []*Toggle
+
+		// online is the topics of members whose devices are neither offline nor stale.
+		online map[string]bool
+	}
+)
+
+// WithGroups adds groups from config to the groups that devices have joined by topic.
+func (h Home) WithGroups(groups []GroupConfig) Home {
+	membersByGroup := map[string]map[string]*Toggle{}
+	join := func(group string, power *Toggle) {
+		if _, ok := membersByGroup[group]; !ok {
+			membersByGroup[group] = map[string]*Toggle{}
+		}
+		membersByGroup[group][power.topic] = power
+	}
+
+	for _, zone := range h.zonesByName {
+		for _, device := range zone.devicesByName {
+			power, ok := device.controlsByName["power"].(*Toggle)
+			if !ok {
+				continue
+			}
+			for _, group := range device.groups {
+				join(group, power)
+			}
+		}
+	}
+	for _, gc := range groups {
+		if _, ok := membersByGroup[gc.Name]; !ok {
+			membersByGroup[gc.Name] = map[string]*Toggle{}
+		}
+		for _, path := range gc.Devices {
+			parts := strings.SplitN(path, "/", 2)
+			if len(parts) != 2 {
+				continue
+			}
+			device, ok := h.zonesByName[parts[0]].devicesByName[parts[1]]
+			if !ok {
+				continue
+			}
+			if power, ok := device.controlsByName["power"].(*Toggle); ok {
+				join(gc.Name, power)
+			}
+		}
+	}
+
+	h.groupsByName = map[string]*Group{}
+	for name, members := range membersByGroup {
+		g := &Group{name: name}
+		for _, member := range members {
+			g.members = append(g.members, member)
+		}
+		sort.Slice(g.members, func(i, j int) bool {
+			return g.members[i].topic < g.members[j].topic
+		})
+		h.groupsByName[name] = g
+	}
+	return h.withOnlineMembers()
+}
+
+// withOnlineMembers returns h with each group's online members updated from the status of their devices.
+// It copies the groups, so h can be shared.
+func (h Home) withOnlineMembers() Home {
+	groupsByName := make(map[string]*Group, len(h.groupsByName))
+	for name, group := range h.groupsByName {
+		g := &Group{name: group.name, members: group.members, online: map[string]bool{}}
+		for _, member := range g.members {
+			zone, device, _, ok := ParseTopic(member.topic)
+			if !ok {
+				continue
+			}
+			if d, ok := h.zonesByName[zone].devicesByName[device]; ok && d.Status() == "online" {
+				g.online[member.topic] = true
+			}
+		}
+		groupsByName[name] = g
+	}
+	h.groupsByName = groupsByName
+	return h
+}
+
+// Groups returns every group, by name.
+func (h Home) Groups() []*Group {
+	var groups []*Group
+	for _, group := range h.groupsByName {
+		groups = append(groups, group)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].name < groups[j].name
+	})
+	return groups
+}
+
+func (h Home) Group(name string) (*Group, bool) {
+	group, ok := h.groupsByName[name]
+	return group, ok
+}
+
+func (g *Group) Name() string {
+	return g.name
+}
+func (g *Group) DisplayName() string {
+	return displayName(display{}, g.name)
+}
+func (g *Group) Topic() string {
+	return "home/" + GroupsZone + "/" + g.name + "/power"
+}
+
+// Members returns the power Toggles of the group's devices.
+func (g *Group) Members() []*Toggle {
+	return g.members
+}
+
+// State is "all" if every online member is on, "none" if none are, and "some" otherwise.
+// Offline and stale members are left out, as writes to the group leave them alone.
+func (g *Group) State() string {
+	on := 0
+	for _, member := range g.members {
+		if g.online[member.topic] && member.Value {
+			on++
+		}
+	}
+	switch {
+	case on == 0:
+		return "none"
+	case on == len(g.online):
+		return "all"
+	default:
+		return "some"
+	}
+}
+
+// Value is whether every online member is on.
+func (g *Group) Value() bool {
+	return g.State() == "all"
+}
+
+// Mixed is whether some but not all online members are on.
+func (g *Group) Mixed() bool {
+	return g.State() == "some"
+}
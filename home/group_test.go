@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package home
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGroupState(t *testing.T) {
+	tests := []struct {
+		name          string
+		valuesByTopic map[string]string
+		stale         bool
+		want          string
+	}{
+		{
+			name: "all on",
+			valuesByTopic: map[string]string{
+				"home/kitchen/radio/power":  "on",
+				"home/kitchen/kettle/power": "on",
+			},
+			want: "all",
+		},
+		{
+			name: "some on",
+			valuesByTopic: map[string]string{
+				"home/kitchen/radio/power":  "on",
+				"home/kitchen/kettle/power": "off",
+			},
+			want: "some",
+		},
+		{
+			// Writes to the group leave the offline kettle alone, so it mustn't hold the group at "some".
+			name: "offline member off",
+			valuesByTopic: map[string]string{
+				"home/kitchen/radio/power":   "on",
+				"home/kitchen/kettle/power":  "off",
+				"home/kitchen/kettle/status": "offline",
+			},
+			want: "all",
+		},
+		{
+			name: "every member stale",
+			valuesByTopic: map[string]string{
+				"home/kitchen/radio/power":  "on",
+				"home/kitchen/kettle/power": "on",
+			},
+			stale: true,
+			want:  "none",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := OfValuesByTopic(tt.valuesByTopic).WithGroups([]GroupConfig{{
+				Name:    "kitchen",
+				Devices: []string{"kitchen/radio", "kitchen/kettle"},
+			}})
+			if tt.stale {
+				h = h.MarkStale(time.Now())
+			}
+			group, ok := h.Group("kitchen")
+			if !ok {
+				t.Fatal("no kitchen group")
+			}
+			if got := group.State(); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+			if got := len(group.Members()); got != 2 {
+				t.Errorf("got %v members, want 2", got)
+			}
+		})
+	}
+}
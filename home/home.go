@@ -5,22 +5,34 @@
 package home
 
 import (
+	"fmt"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 )
 
+// All stands for every zone or every device in bulk actions, e.g. home/_all/_all/power.
+const All = "_all"
+
 type (
 	Home struct {
-		zonesByName map[string]Zone
+		zonesByName  map[string]Zone
+		groupsByName map[string]*Group
 	}
 	Zone struct {
 		name          string
 		devicesByName map[string]Device
+		display       display
 	}
 	Device struct {
 		name           string
 		controlsByName map[string]Control
+		offline        bool
+		stale          bool
+		updatedAt      time.Time
+		groups         []string
+		display        display
 	}
 
 	Control interface {
@@ -41,6 +53,9 @@ type (
 		Value int
 		Min   int
 		Max   int
+
+		// Unit is the symbol of the unit of Value, such as "%".
+		Unit string
 	}
 
 	Toggle struct {
@@ -53,11 +68,12 @@ type (
 func OfValuesByTopic(valuesByTopic map[string]string) Home {
 	zonesByName := map[string]Zone{}
 
-	insertControl := func(zone, device, control string, c Control) {
+	insertDevice := func(zone, device string) map[string]Device {
 		if _, ok := zonesByName[zone]; !ok {
 			zonesByName[zone] = Zone{
 				name:          zone,
 				devicesByName: map[string]Device{},
+				display:       unlisted,
 			}
 		}
 		devicesByName := zonesByName[zone].devicesByName
@@ -65,9 +81,13 @@ func OfValuesByTopic(valuesByTopic map[string]string) Home {
 			devicesByName[device] = Device{
 				name:           device,
 				controlsByName: map[string]Control{},
+				display:        unlisted,
 			}
 		}
-		controlsByName := devicesByName[device].controlsByName
+		return devicesByName
+	}
+	insertControl := func(zone, device, control string, c Control) {
+		controlsByName := insertDevice(zone, device)[device].controlsByName
 		controlsByName[control] = c
 	}
 
@@ -75,13 +95,30 @@ func OfValuesByTopic(valuesByTopic map[string]string) Home {
 		if v == "" {
 			continue
 		}
-		parts := strings.Split(topic, "/")
-		if len(parts) != 4 {
+		zone, device, control, ok := ParseTopic(topic)
+		if !ok {
 			continue
 		}
-		zone, device, control := parts[1], parts[2], parts[3]
 
 		switch {
+		case control == "status":
+			// The device's availability, e.g. from an MQTT Last Will and Testament.
+			devicesByName := insertDevice(zone, device)
+			d := devicesByName[device]
+			d.offline = v == "offline"
+			devicesByName[device] = d
+		case control == "groups":
+			// The groups the device has joined, one per line.
+			// As in config, names can't contain '/', as the group's topic couldn't be routed.
+			devicesByName := insertDevice(zone, device)
+			d := devicesByName[device]
+			d.groups = nil
+			for _, group := range strings.Split(v, "\n") {
+				if group = strings.TrimSpace(group); group != "" && !strings.Contains(group, "/") {
+					d.groups = append(d.groups, group)
+				}
+			}
+			devicesByName[device] = d
 		case control == "power":
 			on := false
 			if v == "on" {
@@ -103,6 +140,7 @@ func OfValuesByTopic(valuesByTopic map[string]string) Home {
 				Value: value,
 				Min:   0,
 				Max:   100,
+				Unit:  "%",
 			})
 		case strings.HasSuffix(control, "_degrees"):
 			value, err := strconv.Atoi(v)
@@ -115,6 +153,7 @@ func OfValuesByTopic(valuesByTopic map[string]string) Home {
 				Value: value,
 				Min:   0,
 				Max:   360,
+				Unit:  "°",
 			})
 		case control == "kelvin":
 			// TODO: Un-magic kelvin.
@@ -128,6 +167,7 @@ func OfValuesByTopic(valuesByTopic map[string]string) Home {
 				Value: value,
 				Min:   2500,
 				Max:   9000,
+				Unit:  "K",
 			})
 		case strings.HasSuffix(control, "_enum"):
 			var values []string
@@ -149,34 +189,158 @@ func OfValuesByTopic(valuesByTopic map[string]string) Home {
 	}
 }
 
+// WithDevice returns a copy of h with one device rebuilt from all of its topics, or removed if it has none.
+// UpdatedAt is when the device last sent an update, for MarkStale.
+// Only the maps on the way to the device are copied, so Homes can share the rest, and be updated one message at a time.
+func (h Home) WithDevice(zone, device string, valuesByTopic map[string]string, updatedAt time.Time) Home {
+	rebuilt, ok := OfValuesByTopic(valuesByTopic).zonesByName[zone].devicesByName[device]
+	rebuilt.updatedAt = updatedAt
+
+	zonesByName := make(map[string]Zone, len(h.zonesByName)+1)
+	for name, z := range h.zonesByName {
+		zonesByName[name] = z
+	}
+	z, exists := zonesByName[zone]
+	if !exists {
+		z = Zone{name: zone, display: unlisted}
+	}
+	devicesByName := make(map[string]Device, len(z.devicesByName)+1)
+	for name, d := range z.devicesByName {
+		devicesByName[name] = d
+	}
+	if ok {
+		devicesByName[device] = rebuilt
+	} else {
+		delete(devicesByName, device)
+	}
+	z.devicesByName = devicesByName
+
+	if len(devicesByName) > 0 {
+		zonesByName[zone] = z
+	} else {
+		delete(zonesByName, zone)
+	}
+	return Home{
+		zonesByName:  zonesByName,
+		groupsByName: h.groupsByName,
+	}
+}
+
+// ParseTopic splits a control topic of the form home/{zone}/{device}/{control}.
+func ParseTopic(topic string) (zone, device, control string, ok bool) {
+	parts := strings.Split(topic, "/")
+	if len(parts) != 4 || parts[0] != "home" {
+		return "", "", "", false
+	}
+	return parts[1], parts[2], parts[3], true
+}
+
+// MarkStale marks devices as stale if they haven't been updated since cutoff.
+// It returns a copy, so h can be shared.
+func (h Home) MarkStale(cutoff time.Time) Home {
+	zonesByName := make(map[string]Zone, len(h.zonesByName))
+	for zoneName, zone := range h.zonesByName {
+		devicesByName := make(map[string]Device, len(zone.devicesByName))
+		for name, device := range zone.devicesByName {
+			device.stale = device.updatedAt.Before(cutoff)
+			devicesByName[name] = device
+		}
+		zone.devicesByName = devicesByName
+		zonesByName[zoneName] = zone
+	}
+	h.zonesByName = zonesByName
+	return h.withOnlineMembers()
+}
+
+// Zones returns the zones that aren't hidden, in Layout order.
 func (h Home) Zones() []Zone {
 	var zones []Zone
 	for _, zone := range h.zonesByName {
+		if zone.display.hidden {
+			continue
+		}
 		zones = append(zones, zone)
 	}
 	sort.Slice(zones, func(i, j int) bool {
-		return zones[i].name < zones[j].name
+		return before(zones[i].display, zones[i].name, zones[j].display, zones[j].name)
 	})
 	return zones
 }
 
+// Zone returns the named zone, even if it is hidden.
+func (h Home) Zone(name string) (Zone, bool) {
+	zone, ok := h.zonesByName[name]
+	return zone, ok
+}
+
 func (z Zone) Name() string {
 	return z.name
 }
+func (z Zone) DisplayName() string {
+	return displayName(z.display, z.name)
+}
+func (z Zone) Icon() string {
+	return z.display.icon
+}
+func (z Zone) Pinned() bool {
+	return z.display.pinned
+}
+
+// Devices returns the devices that aren't hidden, in Layout order.
 func (z Zone) Devices() []Device {
 	var devices []Device
 	for _, device := range z.devicesByName {
+		if device.display.hidden {
+			continue
+		}
 		devices = append(devices, device)
 	}
 	sort.Slice(devices, func(i, j int) bool {
-		return devices[i].name < devices[j].name
+		return before(devices[i].display, devices[i].name, devices[j].display, devices[j].name)
 	})
 	return devices
 }
 
+// HasControl returns whether any visible device in the zone has the named control, e.g. "power".
+func (z Zone) HasControl(name string) bool {
+	for _, device := range z.Devices() {
+		if _, ok := device.Control(name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Device returns the named device, even if it is hidden.
+func (z Zone) Device(name string) (Device, bool) {
+	device, ok := z.devicesByName[name]
+	return device, ok
+}
+
 func (d Device) Name() string {
 	return d.name
 }
+func (d Device) DisplayName() string {
+	return displayName(d.display, d.name)
+}
+func (d Device) Icon() string {
+	return d.display.icon
+}
+func (d Device) Pinned() bool {
+	return d.display.pinned
+}
+
+// Status is "offline" if the device has said so on its status topic, "stale" if it hasn't been updated recently, and "online" otherwise.
+func (d Device) Status() string {
+	switch {
+	case d.offline:
+		return "offline"
+	case d.stale:
+		return "stale"
+	default:
+		return "online"
+	}
+}
 func (d Device) Controls() []Control {
 	var controls []Control
 	for _, control := range d.controlsByName {
@@ -188,6 +352,12 @@ func (d Device) Controls() []Control {
 	return controls
 }
 
+// Control returns the control with the given topic name, e.g. "brightness_percent".
+func (d Device) Control(name string) (Control, bool) {
+	control, ok := d.controlsByName[name]
+	return control, ok
+}
+
 func (e *Enum) Name() string {
 	return e.name
 }
@@ -206,3 +376,33 @@ func (t *Toggle) Name() string {
 func (t *Toggle) Topic() string {
 	return t.topic
 }
+
+// ValidateValue returns an error if value can't be written to the control.
+func ValidateValue(c Control, value string) error {
+	switch c := c.(type) {
+	case *Enum:
+		if len(c.Values) == 0 {
+			// We don't know what values it takes.
+			return nil
+		}
+		for _, v := range c.Values {
+			if v == value {
+				return nil
+			}
+		}
+		return fmt.Errorf("%q is not one of %v", value, strings.Join(c.Values, ", "))
+	case *Range:
+		n, err := strconv.Atoi(value)
+		if err != nil || n < c.Min || n > c.Max {
+			return fmt.Errorf("%q is not a number from %d to %d", value, c.Min, c.Max)
+		}
+		return nil
+	case *Toggle, *Group:
+		if value != "on" && value != "off" {
+			return fmt.Errorf("%q is not on or off", value)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown control type %T", c)
+	}
+}
@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package home
+
+import (
+	"strings"
+)
+
+type (
+	// Layout customizes the order and display of zones and devices.
+	// Zones and devices not in the Layout are shown after those that are, alphabetically.
+	Layout struct {
+		Zones []ZoneLayout
+	}
+	ZoneLayout struct {
+		Name    string
+		Alias   string
+		Icon    string
+		Hidden  bool
+		Pinned  bool
+		Devices []DeviceLayout
+	}
+	DeviceLayout struct {
+		Name   string
+		Alias  string
+		Icon   string
+		Hidden bool
+		Pinned bool
+	}
+
+	// display is how a zone or device is shown.
+	display struct {
+		alias  string
+		icon   string
+		hidden bool
+		pinned bool
+
+		// order is the position in the Layout, or -1 if it isn't listed.
+		order int
+	}
+)
+
+var unlisted = display{order: -1}
+
+// WithLayout applies a Layout to the zones and devices of h.
+// It returns a copy, so h can be shared.
+func (h Home) WithLayout(l Layout) Home {
+	zoneLayouts := map[string]int{}
+	for i, zl := range l.Zones {
+		zoneLayouts[zl.Name] = i
+	}
+
+	zonesByName := make(map[string]Zone, len(h.zonesByName))
+	for name, zone := range h.zonesByName {
+		zonesByName[name] = zone
+	}
+	h.zonesByName = zonesByName
+
+	for name, zone := range h.zonesByName {
+		i, ok := zoneLayouts[name]
+		if !ok {
+			continue
+		}
+		devicesByName := make(map[string]Device, len(zone.devicesByName))
+		for name, device := range zone.devicesByName {
+			devicesByName[name] = device
+		}
+		zone.devicesByName = devicesByName
+
+		zl := l.Zones[i]
+		zone.display = display{
+			alias:  zl.Alias,
+			icon:   zl.Icon,
+			hidden: zl.Hidden,
+			pinned: zl.Pinned,
+			order:  i,
+		}
+
+		for j, dl := range zl.Devices {
+			device, ok := zone.devicesByName[dl.Name]
+			if !ok {
+				continue
+			}
+			device.display = display{
+				alias:  dl.Alias,
+				icon:   dl.Icon,
+				hidden: dl.Hidden,
+				pinned: dl.Pinned,
+				order:  j,
+			}
+			zone.devicesByName[dl.Name] = device
+		}
+		h.zonesByName[name] = zone
+	}
+	return h
+}
+
+// before returns whether something displayed as a and named x comes before something displayed as b and named y.
+// Pinned things come first, then things in the Layout in its order, then everything else by name.
+func before(a display, x string, b display, y string) bool {
+	switch {
+	case a.pinned != b.pinned:
+		return a.pinned
+	case (a.order < 0) != (b.order < 0):
+		return a.order >= 0
+	case a.order != b.order:
+		return a.order < b.order
+	default:
+		return x < y
+	}
+}
+
+// displayName is the alias if set, otherwise the name in title case, e.g. "living-room" => "Living Room".
+func displayName(d display, name string) string {
+	if d.alias != "" {
+		return d.alias
+	}
+	return strings.Title(strings.Replace(name, "-", " ", -1))
+}
@@ -0,0 +1,160 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package home
+
+import (
+	"reflect"
+	"testing"
+)
+
+// testHome has several zones and devices with nothing but power.
+func testHome() Home {
+	return OfValuesByTopic(map[string]string{
+		"home/attic/fan/power":           "off",
+		"home/bedroom/alarm-clock/power": "on",
+		"home/bedroom/fan/power":         "off",
+		"home/bedroom/lamp/power":        "on",
+		"home/kitchen/radio/power":       "off",
+		"home/living-room/tv/power":      "on",
+	})
+}
+
+func zoneNames(h Home) []string {
+	var names []string
+	for _, zone := range h.Zones() {
+		names = append(names, zone.Name())
+	}
+	return names
+}
+
+func deviceNames(h Home, zone string) []string {
+	z, ok := h.Zone(zone)
+	if !ok {
+		return nil
+	}
+	var names []string
+	for _, device := range z.Devices() {
+		names = append(names, device.Name())
+	}
+	return names
+}
+
+func TestWithLayoutOrder(t *testing.T) {
+	tests := []struct {
+		name        string
+		layout      Layout
+		wantZones   []string
+		wantDevices []string
+	}{
+		{
+			name:        "no layout",
+			wantZones:   []string{"attic", "bedroom", "kitchen", "living-room"},
+			wantDevices: []string{"alarm-clock", "fan", "lamp"},
+		},
+		{
+			name: "layout order first",
+			layout: Layout{Zones: []ZoneLayout{
+				{Name: "kitchen"},
+				{Name: "bedroom", Devices: []DeviceLayout{{Name: "lamp"}}},
+			}},
+			wantZones:   []string{"kitchen", "bedroom", "attic", "living-room"},
+			wantDevices: []string{"lamp", "alarm-clock", "fan"},
+		},
+		{
+			name: "pinned before layout order",
+			layout: Layout{Zones: []ZoneLayout{
+				{Name: "kitchen"},
+				{Name: "bedroom", Devices: []DeviceLayout{{Name: "lamp"}, {Name: "fan", Pinned: true}}},
+				{Name: "living-room", Pinned: true},
+			}},
+			wantZones:   []string{"living-room", "kitchen", "bedroom", "attic"},
+			wantDevices: []string{"fan", "lamp", "alarm-clock"},
+		},
+		{
+			name: "hidden",
+			layout: Layout{Zones: []ZoneLayout{
+				{Name: "attic", Hidden: true},
+				{Name: "bedroom", Devices: []DeviceLayout{{Name: "fan", Hidden: true}}},
+			}},
+			wantZones:   []string{"bedroom", "kitchen", "living-room"},
+			wantDevices: []string{"alarm-clock", "lamp"},
+		},
+		{
+			name: "unknown zones and devices",
+			layout: Layout{Zones: []ZoneLayout{
+				{Name: "garage", Pinned: true},
+				{Name: "bedroom", Devices: []DeviceLayout{{Name: "bike", Pinned: true}}},
+			}},
+			wantZones:   []string{"bedroom", "attic", "kitchen", "living-room"},
+			wantDevices: []string{"alarm-clock", "fan", "lamp"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := testHome().WithLayout(tt.layout)
+			if got := zoneNames(h); !reflect.DeepEqual(got, tt.wantZones) {
+				t.Errorf("got zones %v, want %v", got, tt.wantZones)
+			}
+			if got := deviceNames(h, "bedroom"); !reflect.DeepEqual(got, tt.wantDevices) {
+				t.Errorf("got bedroom devices %v, want %v", got, tt.wantDevices)
+			}
+		})
+	}
+}
+
+func TestWithLayoutDisplay(t *testing.T) {
+	h := testHome()
+	withLayout := h.WithLayout(Layout{Zones: []ZoneLayout{{
+		Name:    "bedroom",
+		Alias:   "Main bedroom",
+		Icon:    "bed",
+		Hidden:  true,
+		Devices: []DeviceLayout{{Name: "lamp", Alias: "Reading lamp", Icon: "lightbulb"}},
+	}}})
+
+	// Hidden zones can still be found by name, as for writes.
+	zone, ok := withLayout.Zone("bedroom")
+	if !ok {
+		t.Fatal("no hidden bedroom")
+	}
+	if got, want := zone.DisplayName(), "Main bedroom"; got != want {
+		t.Errorf("got zone %q, want %q", got, want)
+	}
+	if got, want := zone.Icon(), "bed"; got != want {
+		t.Errorf("got zone icon %q, want %q", got, want)
+	}
+	device, _ := zone.Device("lamp")
+	if got, want := device.DisplayName(), "Reading lamp"; got != want {
+		t.Errorf("got device %q, want %q", got, want)
+	}
+	if got, want := device.Icon(), "lightbulb"; got != want {
+		t.Errorf("got device icon %q, want %q", got, want)
+	}
+
+	// The Home the layout was applied to is unchanged, so it can be shared.
+	zone, _ = h.Zone("bedroom")
+	device, _ = zone.Device("lamp")
+	if zone.DisplayName() != "Bedroom" || device.DisplayName() != "Lamp" {
+		t.Errorf("got %q and %q in the original home, want them unchanged", zone.DisplayName(), device.DisplayName())
+	}
+}
+
+func TestDisplayName(t *testing.T) {
+	tests := []struct {
+		alias string
+		name  string
+		want  string
+	}{
+		{name: "kitchen", want: "Kitchen"},
+		{name: "living-room", want: "Living Room"},
+		{name: "tv", want: "Tv"},
+		{alias: "Lounge", name: "living-room", want: "Lounge"},
+	}
+	for _, tt := range tests {
+		if got := displayName(display{alias: tt.alias}, tt.name); got != tt.want {
+			t.Errorf("got %q for %q with alias %q, want %q", got, tt.name, tt.alias, tt.want)
+		}
+	}
+}
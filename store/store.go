@@ -0,0 +1,256 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+// Package store keeps the state of the home, from the messages retained by the broker.
+package store
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.eth.moe/catbus-web-ui/home"
+)
+
+// maxChanges is how many changes are kept for ChangedSince; clients further behind than that get everything.
+const maxChanges = 10000
+
+type (
+	// Store is the latest payload of each topic under home/, and the home made from them.
+	// The home is updated one device at a time as messages arrive, and read from immutable Snapshots.
+	Store struct {
+		layout     home.Layout
+		groups     []home.GroupConfig
+		staleAfter time.Duration
+		epoch      string
+
+		mu             sync.Mutex
+		payloadByTopic map[string]string
+		version        uint64
+
+		// changes are the latest changes, oldest first, one per version.
+		// They are only ever appended to, so Snapshots can share them.
+		changes []change
+
+		// payloadsByDevice are the topics of each device, by "zone/device", for rebuilding it when one changes.
+		payloadsByDevice  map[string]map[string]string
+		updatedAtByDevice map[string]time.Time
+		home              home.Home
+
+		// seenSinceConnect is the set of topics received since the last (re)connect, until the retained state has arrived.
+		seenSinceConnect map[string]bool
+
+		// snapshot is the latest Snapshot, or nil if something has changed since it was made.
+		snapshot *Snapshot
+		changed  chan struct{}
+	}
+
+	// Snapshot is the state of the Store at a version.
+	// It is never modified, so it can be read without blocking new messages.
+	Snapshot struct {
+		// Epoch is different for each Store, so Versions from another, such as from before a restart, can be told apart.
+		Epoch string
+
+		// Version goes up each time a topic changes.
+		Version uint64
+
+		// Topics is the number of topics with a payload.
+		Topics int
+
+		raw        home.Home
+		home       home.Home
+		staleAfter time.Duration
+		changes    []change
+		changed    chan struct{}
+	}
+
+	// change is a topic being set or removed.
+	change struct {
+		topic   string
+		removed bool
+	}
+)
+
+// New returns an empty Store, whose homes have the given layout and groups, and whose devices go stale after staleAfter, if it is set.
+func New(layout home.Layout, groups []home.GroupConfig, staleAfter time.Duration) *Store {
+	return &Store{
+		layout:            layout,
+		groups:            groups,
+		staleAfter:        staleAfter,
+		epoch:             strconv.FormatInt(time.Now().UnixNano(), 36),
+		payloadByTopic:    map[string]string{},
+		payloadsByDevice:  map[string]map[string]string{},
+		updatedAtByDevice: map[string]time.Time{},
+		home:              home.OfValuesByTopic(nil),
+		changed:           make(chan struct{}),
+	}
+}
+
+// Connected records that the broker has (re)connected, and will send its retained messages again.
+func (s *Store) Connected() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.seenSinceConnect = map[string]bool{}
+}
+
+// Synced records that the broker's retained messages have all arrived.
+// Topics that weren't among them are no longer retained by the broker, so they are removed.
+func (s *Store) Synced() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Already synced since the last connect, so we no longer know which topics the broker sent.
+	if s.seenSinceConnect == nil {
+		return
+	}
+
+	var gone []string
+	for topic := range s.payloadByTopic {
+		if !s.seenSinceConnect[topic] {
+			gone = append(gone, topic)
+		}
+	}
+	for _, topic := range gone {
+		s.set(topic, "", false)
+	}
+	s.seenSinceConnect = nil
+}
+
+// Set records a message from the broker.
+// An empty payload removes the topic.
+// Retained messages are replayed by the broker on (re)connecting, so they only count as the device having been updated the first time it is seen.
+func (s *Store) Set(topic, payload string, retained bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.seenSinceConnect != nil {
+		s.seenSinceConnect[topic] = true
+	}
+	s.set(topic, payload, retained)
+}
+
+// set must be called with mu held.
+func (s *Store) set(topic, payload string, retained bool) {
+	if payload == "" {
+		delete(s.payloadByTopic, topic)
+	} else {
+		s.payloadByTopic[topic] = payload
+	}
+
+	s.version++
+	if len(s.changes) >= 2*maxChanges {
+		// Copy rather than reslice, so the old changes can be freed once no Snapshot has them.
+		s.changes = append([]change(nil), s.changes[len(s.changes)-maxChanges:]...)
+	}
+	s.changes = append(s.changes, change{topic: topic, removed: payload == ""})
+	if s.snapshot != nil {
+		s.snapshot = nil
+		close(s.changed)
+		s.changed = make(chan struct{})
+	}
+
+	parts := strings.Split(topic, "/")
+	if len(parts) < 4 || parts[0] != "home" {
+		return
+	}
+	key := parts[1] + "/" + parts[2]
+	payloads, ok := s.payloadsByDevice[key]
+	if !ok {
+		payloads = map[string]string{}
+		s.payloadsByDevice[key] = payloads
+	}
+	if payload == "" {
+		delete(payloads, topic)
+	} else {
+		payloads[topic] = payload
+	}
+	if payload != "" {
+		// A retained message doesn't say when the device sent it, so a device first seen in the broker's replay counts from then.
+		// Later replays, such as on reconnecting, leave it alone, so devices that have gone quiet stay stale.
+		if _, ok := s.updatedAtByDevice[key]; !ok || !retained {
+			s.updatedAtByDevice[key] = time.Now()
+		}
+	}
+	if len(payloads) == 0 {
+		delete(s.payloadsByDevice, key)
+		delete(s.updatedAtByDevice, key)
+	}
+	s.home = s.home.WithDevice(parts[1], parts[2], payloads, s.updatedAtByDevice[key])
+}
+
+// Snapshot returns the current state.
+func (s *Store) Snapshot() *Snapshot {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.snapshot == nil {
+		s.snapshot = &Snapshot{
+			Epoch:      s.epoch,
+			Version:    s.version,
+			Topics:     len(s.payloadByTopic),
+			raw:        s.home,
+			home:       s.home.WithLayout(s.layout).WithGroups(s.groups),
+			staleAfter: s.staleAfter,
+			changes:    s.changes,
+			changed:    s.changed,
+		}
+	}
+	return s.snapshot
+}
+
+// Home returns the home, with its layout and groups, and with devices marked stale as of now.
+func (s *Snapshot) Home() home.Home {
+	if s.staleAfter <= 0 {
+		return s.home
+	}
+	return s.home.MarkStale(time.Now().Add(-s.staleAfter))
+}
+
+// Raw returns the home as the broker has it, without the layout, groups, or staleness.
+func (s *Snapshot) Raw() home.Home {
+	return s.raw
+}
+
+// Changed is closed once there is a newer Snapshot.
+func (s *Snapshot) Changed() <-chan struct{} {
+	return s.changed
+}
+
+// ChangedSince returns the topics that have changed after version since, and every topic above them.
+// For example, a change to home/kitchen/radio/input_enum/values marks home/kitchen/radio/input_enum as changed.
+// Removed lists the topics that have gone since, in order.
+// If since is too long ago to know what has changed, ok is false.
+func (s *Snapshot) ChangedSince(since uint64) (changed map[string]bool, removed []string, ok bool) {
+	// The first change kept is the one that made version s.Version - len(s.changes) + 1.
+	oldest := s.Version - uint64(len(s.changes))
+	if since < oldest {
+		return nil, nil, false
+	}
+
+	changed = map[string]bool{}
+	removedByTopic := map[string]bool{}
+	if since < s.Version {
+		for _, c := range s.changes[since-oldest:] {
+			removedByTopic[c.topic] = c.removed
+			for topic := c.topic; ; {
+				changed[topic] = true
+				i := strings.LastIndex(topic, "/")
+				if i < 0 {
+					break
+				}
+				topic = topic[:i]
+			}
+		}
+	}
+	removed = []string{}
+	for topic, isRemoved := range removedByTopic {
+		if isRemoved {
+			removed = append(removed, topic)
+		}
+	}
+	sort.Strings(removed)
+	return changed, removed, true
+}
@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package store
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"go.eth.moe/catbus-web-ui/home"
+)
+
+func statusOf(s *Store, zone, device string) string {
+	z, ok := s.Snapshot().Home().Zone(zone)
+	if !ok {
+		return "missing"
+	}
+	d, ok := z.Device(device)
+	if !ok {
+		return "missing"
+	}
+	return d.Status()
+}
+
+func TestStaleAfterReconnect(t *testing.T) {
+	staleAfter := 100 * time.Millisecond
+	s := New(home.Layout{}, nil, staleAfter)
+
+	// The broker's retained state doesn't say when the device last published it, so it counts from when it arrived.
+	s.Connected()
+	s.Set("home/bedroom/lamp/power", "on", true)
+	s.Set("home/kitchen/radio/power", "on", true)
+	s.Synced()
+	if got := statusOf(s, "bedroom", "lamp"); got != "online" {
+		t.Errorf("got %q from only retained messages, want online", got)
+	}
+
+	time.Sleep(2 * staleAfter)
+	if got := statusOf(s, "bedroom", "lamp"); got != "stale" {
+		t.Errorf("got %q without updates, want stale", got)
+	}
+	s.Set("home/bedroom/lamp/power", "off", false)
+	if got := statusOf(s, "bedroom", "lamp"); got != "online" {
+		t.Errorf("got %q after an update, want online", got)
+	}
+
+	// Reconnecting replays the retained state, which doesn't bring the dead radio back.
+	s.Connected()
+	s.Set("home/bedroom/lamp/power", "off", true)
+	s.Set("home/kitchen/radio/power", "on", true)
+	s.Synced()
+	if got := statusOf(s, "bedroom", "lamp"); got != "online" {
+		t.Errorf("got lamp %q after reconnecting, want online", got)
+	}
+	if got := statusOf(s, "kitchen", "radio"); got != "stale" {
+		t.Errorf("got radio %q after reconnecting, want stale", got)
+	}
+}
+
+func TestChangedSince(t *testing.T) {
+	s := New(home.Layout{}, nil, 0)
+	s.Set("home/bedroom/lamp/power", "on", true)
+	s.Set("home/kitchen/radio/power", "on", true)
+	before := s.Snapshot()
+
+	s.Set("home/bedroom/lamp/power", "off", false)
+	s.Set("home/kitchen/radio/power", "", false)
+	s.Set("home/garden/shed/power", "", false)
+	after := s.Snapshot()
+
+	changed, removed, ok := after.ChangedSince(before.Version)
+	if !ok {
+		t.Fatalf("could not get changes since %v", before.Version)
+	}
+	for _, topic := range []string{"home/bedroom/lamp/power", "home/bedroom/lamp", "home/bedroom", "home", "home/kitchen/radio/power"} {
+		if !changed[topic] {
+			t.Errorf("%v is not changed", topic)
+		}
+	}
+	if want := []string{"home/garden/shed/power", "home/kitchen/radio/power"}; !reflect.DeepEqual(removed, want) {
+		t.Errorf("got removed %v, want %v", removed, want)
+	}
+
+	// Snapshots don't change with the Store.
+	if changed, _, _ := before.ChangedSince(before.Version); len(changed) != 0 {
+		t.Errorf("got changes %v in an earlier snapshot", changed)
+	}
+}
+
+func TestChangedSinceTooLongAgo(t *testing.T) {
+	s := New(home.Layout{}, nil, 0)
+	for i := 0; i < 2*maxChanges+1; i++ {
+		s.Set("home/bedroom/lamp/brightness_percent", strconv.Itoa(i%100+1), false)
+	}
+	snapshot := s.Snapshot()
+
+	if _, _, ok := snapshot.ChangedSince(0); ok {
+		t.Error("got changes since 0, want to be too long ago")
+	}
+	changed, _, ok := snapshot.ChangedSince(snapshot.Version - 1)
+	if !ok || !changed["home/bedroom/lamp/brightness_percent"] {
+		t.Errorf("got %v, %v since the previous version, want the brightness", changed, ok)
+	}
+}
+
+func TestSyncedTwice(t *testing.T) {
+	s := New(home.Layout{}, nil, 0)
+	s.Connected()
+	s.Set("home/bedroom/lamp/power", "on", true)
+	s.Synced()
+
+	// With nothing to say what the broker sent, a second sync must not remove anything.
+	s.Synced()
+	if got := s.Snapshot().Topics; got != 1 {
+		t.Errorf("got %v topics after syncing twice, want 1", got)
+	}
+}
@@ -0,0 +1,186 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package systemd
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// setenv sets an environment variable for the rest of the test, or unsets it if value is nil.
+func setenv(t *testing.T, key string, value *string) {
+	t.Helper()
+
+	old, ok := os.LookupEnv(key)
+	t.Cleanup(func() {
+		if ok {
+			os.Setenv(key, old)
+		} else {
+			os.Unsetenv(key)
+		}
+	})
+	if value == nil {
+		os.Unsetenv(key)
+	} else {
+		os.Setenv(key, *value)
+	}
+}
+
+func str(s string) *string {
+	return &s
+}
+
+func TestNotify(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notify.sock")
+	conn, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Name: path, Net: "unixgram"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	setenv(t, "NOTIFY_SOCKET", str(path))
+
+	if err := Notify("READY=1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := conn.SetReadDeadline(time.Now().Add(5 * time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, 64)
+	n, err := conn.Read(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := string(buf[:n]); got != "READY=1" {
+		t.Errorf("got %q, want %q", got, "READY=1")
+	}
+}
+
+func TestNotifyWithoutSystemd(t *testing.T) {
+	setenv(t, "NOTIFY_SOCKET", nil)
+	if err := Notify("READY=1"); err != nil {
+		t.Errorf("got %v, want nothing to happen", err)
+	}
+}
+
+func TestWatchdogInterval(t *testing.T) {
+	pid := strconv.Itoa(os.Getpid())
+	tests := []struct {
+		name string
+		pid  *string
+		usec *string
+		want time.Duration
+	}{
+		{name: "disabled"},
+		{name: "enabled", usec: str("5000000"), want: 5 * time.Second},
+		{name: "for this process", pid: str(pid), usec: str("5000000"), want: 5 * time.Second},
+		{name: "for another process", pid: str("1"), usec: str("5000000")},
+		{name: "zero", usec: str("0")},
+		{name: "invalid", usec: str("soon")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setenv(t, "WATCHDOG_PID", tt.pid)
+			setenv(t, "WATCHDOG_USEC", tt.usec)
+			if got := WatchdogInterval(); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestListenersWithoutSystemd(t *testing.T) {
+	for _, pid := range []*string{nil, str("1")} {
+		setenv(t, "LISTEN_PID", pid)
+		setenv(t, "LISTEN_FDS", str("1"))
+
+		listeners, err := Listeners()
+		if err != nil || listeners != nil {
+			t.Errorf("got %v, %v for LISTEN_PID %v, want none", listeners, err, pid)
+		}
+		if _, ok := os.LookupEnv("LISTEN_FDS"); ok {
+			t.Error("LISTEN_FDS is still set")
+		}
+	}
+}
+
+// TestListeners passes a socket to a copy of the test binary, as systemd would, which answers a connection on it.
+func TestListeners(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	f, err := l.(*net.TCPListener).File()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	cmd := exec.Command(os.Args[0], "-test.run=TestListenersHelper")
+	cmd.Env = append(os.Environ(), "SYSTEMD_TEST_HELPER=1")
+	// The first extra file is file descriptor 3, where systemd puts the first socket.
+	cmd.ExtraFiles = []*os.File{f}
+	cmd.Stderr = os.Stderr
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	// If the test fails before the helper is answered, it would wait forever.
+	defer cmd.Process.Kill()
+
+	conn, err := net.DialTimeout("tcp", l.Addr().String(), 5*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	if err := conn.SetReadDeadline(time.Now().Add(5 * time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ioutil.ReadAll(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "hello" {
+		t.Errorf("got %q, want %q", got, "hello")
+	}
+	if err := cmd.Wait(); err != nil {
+		t.Errorf("helper failed: %v", err)
+	}
+}
+
+// TestListenersHelper is the process that TestListeners passes its socket to.
+func TestListenersHelper(t *testing.T) {
+	if os.Getenv("SYSTEMD_TEST_HELPER") == "" {
+		t.Skip("only run by TestListeners")
+	}
+	// Only the new process knows its own PID.
+	os.Setenv("LISTEN_PID", strconv.Itoa(os.Getpid()))
+	os.Setenv("LISTEN_FDS", "1")
+
+	listeners, err := Listeners()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(listeners) != 1 {
+		t.Fatalf("got %v listeners, want 1", len(listeners))
+	}
+	if _, ok := os.LookupEnv("LISTEN_PID"); ok {
+		t.Error("LISTEN_PID is still set")
+	}
+
+	conn, err := listeners[0].Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	if _, err := conn.Write([]byte("hello")); err != nil {
+		t.Fatal(err)
+	}
+}